@@ -0,0 +1,142 @@
+package trace
+
+import (
+	"context"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	__B3_SINGLE_HEADER   = "b3"
+	__B3_TRACE_ID_HEADER = "x-b3-traceid"
+	__B3_SPAN_ID_HEADER  = "x-b3-spanid"
+	__B3_SAMPLED_HEADER  = "x-b3-sampled"
+	__B3_FLAGS_HEADER    = "x-b3-flags"
+)
+
+var (
+	_ propagation.TextMapPropagator = B3Propagator{}
+)
+
+// B3Propagator propagates span context in the B3 format. Both the single
+// b3 header and the multiple X-B3-* headers are accepted on Extract, while
+// Inject writes the encoding selected by SingleHeader.
+type B3Propagator struct {
+	SingleHeader bool
+}
+
+// Inject implements propagation.TextMapPropagator
+func (p B3Propagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if !sc.IsValid() {
+		return
+	}
+
+	sampled := "0"
+	if sc.IsSampled() {
+		sampled = "1"
+	}
+
+	if p.SingleHeader {
+		carrier.Set(__B3_SINGLE_HEADER, sc.TraceID().String()+"-"+sc.SpanID().String()+"-"+sampled)
+		return
+	}
+	carrier.Set(__B3_TRACE_ID_HEADER, sc.TraceID().String())
+	carrier.Set(__B3_SPAN_ID_HEADER, sc.SpanID().String())
+	carrier.Set(__B3_SAMPLED_HEADER, sampled)
+}
+
+// Extract implements propagation.TextMapPropagator
+func (p B3Propagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	var (
+		sc trace.SpanContext
+		ok bool
+	)
+	if header := carrier.Get(__B3_SINGLE_HEADER); len(header) > 0 {
+		sc, ok = parseB3SingleHeader(header)
+	} else {
+		sc, ok = parseB3MultipleHeader(carrier)
+	}
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// Fields implements propagation.TextMapPropagator
+func (p B3Propagator) Fields() []string {
+	if p.SingleHeader {
+		return []string{__B3_SINGLE_HEADER}
+	}
+	return []string{
+		__B3_TRACE_ID_HEADER,
+		__B3_SPAN_ID_HEADER,
+		__B3_SAMPLED_HEADER,
+	}
+}
+
+func parseB3SingleHeader(header string) (trace.SpanContext, bool) {
+	// {TraceId}-{SpanId}-{SamplingState}-{ParentSpanId}
+	parts := strings.Split(header, "-")
+	if len(parts) < 2 || len(parts) > 4 {
+		// a lone sampling state carries no span context
+		return trace.SpanContext{}, false
+	}
+
+	var sampled string
+	if len(parts) > 2 {
+		sampled = parts[2]
+	}
+	return newB3SpanContext(parts[0], parts[1], sampled, "")
+}
+
+func parseB3MultipleHeader(carrier propagation.TextMapCarrier) (trace.SpanContext, bool) {
+	traceID := carrier.Get(__B3_TRACE_ID_HEADER)
+	spanID := carrier.Get(__B3_SPAN_ID_HEADER)
+	if len(traceID) == 0 || len(spanID) == 0 {
+		return trace.SpanContext{}, false
+	}
+	return newB3SpanContext(traceID, spanID,
+		carrier.Get(__B3_SAMPLED_HEADER),
+		carrier.Get(__B3_FLAGS_HEADER))
+}
+
+func newB3SpanContext(traceIDHex, spanIDHex, sampled, flags string) (trace.SpanContext, bool) {
+	if len(traceIDHex) != 16 && len(traceIDHex) != 32 {
+		return trace.SpanContext{}, false
+	}
+	if len(spanIDHex) != 16 {
+		return trace.SpanContext{}, false
+	}
+
+	traceID, err := trace.TraceIDFromHex(leftPadHex(traceIDHex, 32))
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+	spanID, err := trace.SpanIDFromHex(spanIDHex)
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+
+	var traceFlags trace.TraceFlags
+	switch {
+	case flags == "1", sampled == "d":
+		// debug implies an accept sampling decision
+		traceFlags = FlagsSampled
+	case sampled == "1", sampled == "true":
+		traceFlags = FlagsSampled
+	case sampled == "", sampled == "0", sampled == "false":
+	default:
+		return trace.SpanContext{}, false
+	}
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: traceFlags,
+		Remote:     true,
+	})
+	return sc, sc.IsValid()
+}
@@ -0,0 +1,134 @@
+package trace
+
+import (
+	"context"
+	"sort"
+
+	"go.opentelemetry.io/otel/baggage"
+)
+
+const (
+	__BAGGAGE_ATTRIBUTE_PREFIX = "baggage."
+	__BAGGAGE_HEADER           = "baggage"
+)
+
+// BaggageRule promotes a baggage member onto every span started by the
+// tracers of a SeverityTracerProvider.
+type BaggageRule struct {
+	// Member is the key of the baggage member.
+	Member string
+	// Attribute is the span attribute key, defaults to "baggage.<Member>".
+	Attribute Key
+}
+
+func (r BaggageRule) attributeKey() Key {
+	if len(r.Attribute) > 0 {
+		return r.Attribute
+	}
+	return Key(__BAGGAGE_ATTRIBUTE_PREFIX + r.Member)
+}
+
+// ContextWithBaggageValue returns a copy of ctx whose baggage has the member
+// key set to value.
+func ContextWithBaggageValue(ctx context.Context, key, value string) (context.Context, error) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	member, err := baggage.NewMemberRaw(key, value)
+	if err != nil {
+		return ctx, err
+	}
+	bag, err := baggage.FromContext(ctx).SetMember(member)
+	if err != nil {
+		return ctx, err
+	}
+	return baggage.ContextWithBaggage(ctx, bag), nil
+}
+
+// Baggage returns the baggage carried by the span context.
+func (s *SeveritySpan) Baggage() baggage.Baggage {
+	return baggage.FromContext(s.Context())
+}
+
+// BaggageValue returns the value of the baggage member key, or an empty
+// string if it is absent.
+func (s *SeveritySpan) BaggageValue(key string) string {
+	return baggage.FromContext(s.Context()).Member(key).Value()
+}
+
+// SetBaggage sets the baggage member key to value on the span context, and
+// returns the updated context. Spans started from it afterwards inherit the
+// member.
+func (s *SeveritySpan) SetBaggage(key, value string) (context.Context, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ctx, err := ContextWithBaggageValue(s.ctx, key, value)
+	if err != nil {
+		return s.ctx, err
+	}
+	s.ctx = ctx
+	return ctx, nil
+}
+
+func (p *SeverityTracerProvider) promoteBaggage(span *SeveritySpan) {
+	if len(p.baggageRules) == 0 || !span.span.IsRecording() {
+		return
+	}
+
+	bag := baggage.FromContext(span.ctx)
+	if bag.Len() == 0 {
+		return
+	}
+	for _, rule := range p.baggageRules {
+		member := bag.Member(rule.Member)
+		if len(member.Key()) > 0 {
+			span.span.SetAttributes(rule.attributeKey().String(member.Value()))
+		}
+	}
+}
+
+func (p *SeverityTracerProvider) limitBaggage(ctx context.Context) context.Context {
+	if p.baggageMaxMembers <= 0 && p.baggageMaxBytes <= 0 {
+		return ctx
+	}
+
+	bag := baggage.FromContext(ctx)
+	if bag.Len() == 0 {
+		return ctx
+	}
+
+	members := bag.Members()
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].Key() < members[j].Key()
+	})
+
+	var (
+		kept []baggage.Member
+		size int
+	)
+	for _, m := range members {
+		if p.baggageMaxMembers > 0 && len(kept) >= p.baggageMaxMembers {
+			break
+		}
+		n := len(m.String())
+		if len(kept) > 0 {
+			n++ // the ',' delimiter
+		}
+		if p.baggageMaxBytes > 0 && size+n > p.baggageMaxBytes {
+			continue
+		}
+		kept = append(kept, m)
+		size += n
+	}
+	if len(kept) == len(members) {
+		return ctx
+	}
+
+	limited, err := baggage.New(kept...)
+	if err != nil {
+		return baggage.ContextWithoutBaggage(ctx)
+	}
+	return baggage.ContextWithBaggage(ctx, limited)
+}
@@ -0,0 +1,139 @@
+package trace
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSeveritySpan_SetBaggage(t *testing.T) {
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider())
+	tracer := tp.Tracer("test-tracer")
+
+	span := tracer.Open(context.Background(), "request")
+	defer span.End()
+
+	ctx, err := span.SetBaggage("tenant", "acme")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ctx != span.Context() {
+		t.Error("Expected SetBaggage to update the span context")
+	}
+	if span.BaggageValue("tenant") != "acme" {
+		t.Errorf("BaggageValue(tenant): expect %q, but got %q", "acme", span.BaggageValue("tenant"))
+	}
+	if span.Baggage().Len() != 1 {
+		t.Errorf("Expected 1 baggage member, got %d", span.Baggage().Len())
+	}
+
+	child := tracer.Start(span.Context(), "child")
+	defer child.End()
+	if child.BaggageValue("tenant") != "acme" {
+		t.Error("Expected child span to inherit baggage")
+	}
+
+	if _, err := span.SetBaggage("", "invalid"); err == nil {
+		t.Error("Expected error for invalid baggage key")
+	}
+}
+
+func TestSeverityTracerProvider_SetBaggageRules(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+	tp.SetBaggageRules(
+		BaggageRule{Member: "tenant"},
+		BaggageRule{Member: "tier", Attribute: "user.tier"},
+		BaggageRule{Member: "absent"},
+	)
+
+	ctx, _ := ContextWithBaggageValue(context.Background(), "tenant", "acme")
+	ctx, _ = ContextWithBaggageValue(ctx, "tier", "gold")
+	ctx, _ = ContextWithBaggageValue(ctx, "session", "ignored")
+
+	span := tp.Tracer("test-tracer").Start(ctx, "request")
+	span.End()
+
+	attrs := make(map[Key]string)
+	for _, attr := range exporter.GetSpans()[0].Attributes {
+		attrs[attr.Key] = attr.Value.AsString()
+	}
+	if attrs["baggage.tenant"] != "acme" {
+		t.Errorf("baggage.tenant: expect %q, but got %q", "acme", attrs["baggage.tenant"])
+	}
+	if attrs["user.tier"] != "gold" {
+		t.Errorf("user.tier: expect %q, but got %q", "gold", attrs["user.tier"])
+	}
+	if _, ok := attrs["baggage.session"]; ok {
+		t.Error("Expected baggage member without rule not to be promoted")
+	}
+	if _, ok := attrs["baggage.absent"]; ok {
+		t.Error("Expected absent baggage member not to be promoted")
+	}
+}
+
+func TestSeverityTracerProvider_SetBaggageLimits(t *testing.T) {
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider())
+	tp.SetBaggageLimits(2, 0)
+	tracer := tp.Tracer("test-tracer")
+
+	ctx, _ := ContextWithBaggageValue(context.Background(), "a", "1")
+	ctx, _ = ContextWithBaggageValue(ctx, "b", "2")
+	ctx, _ = ContextWithBaggageValue(ctx, "c", "3")
+
+	span := tracer.Start(ctx, "request")
+	defer span.End()
+
+	carrier := make(propagation.MapCarrier)
+	tracer.InjectWithPropagator(span.Context(), propagation.Baggage{}, carrier)
+
+	bag, err := baggage.Parse(carrier.Get("baggage"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bag.Len() != 2 {
+		t.Errorf("Expected 2 injected baggage members, got %d", bag.Len())
+	}
+
+	// byte limit
+	tp.SetBaggageLimits(0, len("a=1,b=2"))
+	carrier = make(propagation.MapCarrier)
+	tracer.InjectWithPropagator(span.Context(), propagation.Baggage{}, carrier)
+	if n := len(strings.Split(carrier.Get("baggage"), ",")); n != 2 {
+		t.Errorf("Expected 2 injected baggage members, got %d", n)
+	}
+
+	// the span itself keeps all members
+	if span.Baggage().Len() != 3 {
+		t.Errorf("Expected span to keep 3 baggage members, got %d", span.Baggage().Len())
+	}
+}
+
+func TestSeveritySpan_Inject_BaggageLimits(t *testing.T) {
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider())
+	tp.SetBaggageLimits(1, 0)
+
+	ctx, _ := ContextWithBaggageValue(context.Background(), "a", "1")
+	ctx, _ = ContextWithBaggageValue(ctx, "b", "2")
+
+	span := tp.Tracer("test-tracer").Start(ctx, "request")
+	defer span.End()
+
+	carrier := make(propagation.MapCarrier)
+	span.Inject(propagation.Baggage{}, carrier)
+
+	bag, err := baggage.Parse(carrier.Get("baggage"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bag.Len() != 1 {
+		t.Errorf("Expected 1 injected baggage member, got %d", bag.Len())
+	}
+}
@@ -373,3 +373,80 @@ func BenchmarkNoopEvent_Operations(b *testing.B) {
 	}
 }
 
+func BenchmarkSeveritySpan_Info_Parallel(b *testing.B) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	)
+	otel.SetTracerProvider(tp)
+
+	tracer := Tracer("benchmark-tracer")
+	span := tracer.Start(context.Background(), "benchmark-span")
+	defer span.End()
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			event := span.Info("Info message %d", i)
+			event.Tags(Key("iteration").Int(i))
+			i++
+		}
+	})
+}
+
+func BenchmarkSeveritySpan_Reply(b *testing.B) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	)
+	otel.SetTracerProvider(tp)
+
+	tracer := Tracer("benchmark-tracer")
+	span := tracer.Start(context.Background(), "benchmark-span")
+	defer span.End()
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for b.Loop() {
+		span.Reply(PASS, nil)
+	}
+}
+
+func BenchmarkSeveritySpan_Reply_Parallel(b *testing.B) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	)
+	otel.SetTracerProvider(tp)
+
+	tracer := Tracer("benchmark-tracer")
+	span := tracer.Start(context.Background(), "benchmark-span")
+	defer span.End()
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			span.Reply(PASS, nil)
+		}
+	})
+}
+
+func BenchmarkSeverityTracer_Start_Suppressed(b *testing.B) {
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider())
+	tracer := tp.Tracer("benchmark-tracer")
+	ctx := SuppressInstrumentation(context.Background())
+
+	b.ResetTimer()
+	b.ReportAllocs()
+
+	for b.Loop() {
+		span := tracer.Start(ctx, "benchmark-span")
+		span.End()
+	}
+}
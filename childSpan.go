@@ -0,0 +1,38 @@
+package trace
+
+import (
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Start starts a child span of s with the tracer which started s. The child
+// follows the DisablePolicy of s if s is disabled, and its context carries the
+// child, so that SpanFromContext(child.Context()) returns it.
+func (s *SeveritySpan) Start(spanName string, opts ...trace.SpanStartOption) *SeveritySpan {
+	return s.startChild(spanName, opts...)
+}
+
+// StartLink is like Start, but starts a new root span linked to s instead of
+// a child, for work caused by s which should not be part of its trace.
+func (s *SeveritySpan) StartLink(spanName string, opts ...trace.SpanStartOption) *SeveritySpan {
+	opts = append(opts,
+		trace.WithNewRoot(),
+		trace.WithLinks(s.Link()))
+	return s.startChild(spanName, opts...)
+}
+
+func (s *SeveritySpan) startChild(spanName string, opts ...trace.SpanStartOption) *SeveritySpan {
+	return s.childTracer().Start(s.Context(), spanName, opts...)
+}
+
+// childTracer returns the tracer which started s, or for spans started by
+// another instrumentation, a tracer of the same provider.
+func (s *SeveritySpan) childTracer() *SeverityTracer {
+	if s.tracer != nil {
+		return s.tracer
+	}
+	if s.span.IsRecording() {
+		return CreateSeverityTracer(s.span.TracerProvider().Tracer(__TRACER_NAME))
+	}
+	// a remote or noop span has no provider
+	return Tracer(__TRACER_NAME)
+}
@@ -0,0 +1,78 @@
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSeveritySpan_Start(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+
+	parent := tp.Tracer("child-test").Start(context.Background(), "parent")
+	child := parent.Start("child")
+
+	if child.TraceID() != parent.TraceID() {
+		t.Errorf("Expected child in trace %s, got %s", parent.TraceID(), child.TraceID())
+	}
+	if SpanFromContext(child.Context()) != child {
+		t.Error("Expected child context to carry the child")
+	}
+	child.End()
+	parent.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+	if spans[0].Parent.SpanID() != parent.SpanID() {
+		t.Errorf("Expected child of %s, got parent %s", parent.SpanID(), spans[0].Parent.SpanID())
+	}
+	if spans[0].InstrumentationScope.Name != "child-test" {
+		t.Errorf("Expected child started by tracer %q, got %q", "child-test", spans[0].InstrumentationScope.Name)
+	}
+}
+
+func TestSeveritySpan_Start_Disabled(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+
+	parent := tp.Tracer("child-test").Start(context.Background(), "parent")
+	parent.Disable(true)
+
+	child := parent.Start("child")
+	if !child.IsDisabled() {
+		t.Error("Expected child of a disabled span to be disabled")
+	}
+	child.End()
+	parent.End()
+
+	if n := len(exporter.GetSpans()); n != 0 {
+		t.Errorf("Expected no exported span, got %d", n)
+	}
+}
+
+func TestSeveritySpan_StartLink(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+
+	parent := tp.Tracer("child-test").Start(context.Background(), "parent")
+	linked := parent.StartLink("linked")
+	linked.End()
+	parent.End()
+
+	if linked.TraceID() == parent.TraceID() {
+		t.Error("Expected linked span to start a new trace")
+	}
+
+	stub := exporter.GetSpans()[0]
+	if stub.Parent.IsValid() {
+		t.Errorf("Expected a root span, got parent %s", stub.Parent.SpanID())
+	}
+	if len(stub.Links) != 1 || stub.Links[0].SpanContext.SpanID() != parent.SpanID() {
+		t.Errorf("Expected link to %s, got %v", parent.SpanID(), stub.Links)
+	}
+}
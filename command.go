@@ -0,0 +1,212 @@
+package trace
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	__REDACTED_VALUE = "***"
+)
+
+var (
+	sensitiveArgvKeywords = []string{
+		"password",
+		"passwd",
+		"secret",
+		"token",
+		"credential",
+		"apikey",
+		"api-key",
+		"api_key",
+	}
+)
+
+// Cmd wraps exec.Cmd to run the command within a child span and to
+// propagate the trace to the child process through its environment.
+type Cmd struct {
+	*exec.Cmd
+
+	// Redactor masks sensitive arguments before they are recorded by Argv.
+	// Defaults to RedactArgv; nil records the arguments as is.
+	Redactor func(argv []string) []string
+
+	ctx    context.Context
+	tracer *SeverityTracer
+	span   *SeveritySpan
+	start  time.Time
+}
+
+// Command returns a Cmd to execute the named program with the given
+// arguments, like exec.CommandContext.
+func (s *SeverityTracer) Command(
+	ctx context.Context,
+	name string,
+	arg ...string) *Cmd {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &Cmd{
+		Cmd:      exec.CommandContext(ctx, name, arg...),
+		Redactor: RedactArgv,
+		ctx:      ctx,
+		tracer:   s,
+	}
+}
+
+// ExtractFromEnv starts a span continuing the trace propagated by Cmd
+// through the environment of the current process.
+func (s *SeverityTracer) ExtractFromEnv(
+	ctx context.Context,
+	spanName string,
+	opts ...trace.SpanStartOption) *SeveritySpan {
+
+	carrier := NewEnvCarrier(os.Environ())
+	return s.Extract(ctx, carrier, spanName, opts...)
+}
+
+// Span returns the span of the command. It is nil until Start is called.
+func (c *Cmd) Span() *SeveritySpan {
+	return c.span
+}
+
+// Start starts the command within a child span, see exec.Cmd.Start.
+func (c *Cmd) Start() error {
+	c.span = c.tracer.Start(c.ctx, c.Args[0])
+	c.span.Tags(__ATTR_COMMAND.String(c.Path))
+
+	argv := append([]string(nil), c.Args[1:]...)
+	if c.Redactor != nil {
+		argv = c.Redactor(argv)
+	}
+	c.span.Argv(argv)
+
+	env := c.Env
+	if env == nil {
+		env = os.Environ()
+	}
+	carrier := NewEnvCarrier(env)
+	// the variables inherited from this process must not leak into the
+	// child when the span has nothing to inject
+	propagator := GetTextMapPropagator()
+	for _, field := range append(propagator.Fields(), __BAGGAGE_HEADER) {
+		delete(carrier, envVarName(field))
+	}
+	c.span.Inject(propagator, carrier)
+	c.Env = carrier.Environ()
+
+	c.start = time.Now()
+	err := c.Cmd.Start()
+	if err != nil {
+		c.span.Err(err)
+		c.span.End()
+	}
+	return err
+}
+
+// Wait waits for the command to exit, records the exit code and duration
+// with Reply and ends the span, see exec.Cmd.Wait.
+func (c *Cmd) Wait() error {
+	err := c.Cmd.Wait()
+	if c.span == nil {
+		// Start was not called, exec.Cmd.Wait reports it
+		return err
+	}
+
+	exitCode := -1
+	if c.ProcessState != nil {
+		exitCode = c.ProcessState.ExitCode()
+	}
+
+	code := PASS
+	if err != nil {
+		code = FAIL
+		c.span.Err(err)
+	}
+	c.span.Reply(code, map[string]any{
+		"exit_code":   exitCode,
+		"duration_ms": float64(time.Since(c.start)) / float64(time.Millisecond),
+	})
+	c.span.End()
+	return err
+}
+
+// Run starts the command and waits for it to complete, see exec.Cmd.Run.
+func (c *Cmd) Run() error {
+	if err := c.Start(); err != nil {
+		return err
+	}
+	return c.Wait()
+}
+
+// Output runs the command and returns its standard output, see
+// exec.Cmd.Output.
+func (c *Cmd) Output() ([]byte, error) {
+	if c.Stdout != nil {
+		return nil, errors.New("exec: Stdout already set")
+	}
+
+	var stdout bytes.Buffer
+	c.Stdout = &stdout
+	err := c.Run()
+	return stdout.Bytes(), err
+}
+
+// CombinedOutput runs the command and returns its combined standard output
+// and standard error, see exec.Cmd.CombinedOutput.
+func (c *Cmd) CombinedOutput() ([]byte, error) {
+	if c.Stdout != nil {
+		return nil, errors.New("exec: Stdout already set")
+	}
+	if c.Stderr != nil {
+		return nil, errors.New("exec: Stderr already set")
+	}
+
+	var output bytes.Buffer
+	c.Stdout = &output
+	c.Stderr = &output
+	err := c.Run()
+	return output.Bytes(), err
+}
+
+// RedactArgv masks the values of arguments which look like passwords,
+// secrets or tokens, either in "--name=value" form or as the argument
+// following such a flag.
+func RedactArgv(argv []string) []string {
+	redacted := make([]string, len(argv))
+	for i := 0; i < len(argv); i++ {
+		arg := argv[i]
+		if name, _, ok := strings.Cut(arg, "="); ok {
+			if isSensitiveArgvName(name) {
+				arg = name + "=" + __REDACTED_VALUE
+			}
+			redacted[i] = arg
+			continue
+		}
+
+		redacted[i] = arg
+		if strings.HasPrefix(arg, "-") && isSensitiveArgvName(arg) && i+1 < len(argv) {
+			i++
+			redacted[i] = __REDACTED_VALUE
+		}
+	}
+	return redacted
+}
+
+func isSensitiveArgvName(name string) bool {
+	name = strings.ToLower(name)
+	for _, keyword := range sensitiveArgvKeywords {
+		if strings.Contains(name, keyword) {
+			return true
+		}
+	}
+	return false
+}
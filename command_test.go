@@ -0,0 +1,148 @@
+package trace
+
+import (
+	"context"
+	"os/exec"
+	"reflect"
+	"strings"
+	"testing"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestSeverityTracer_Command(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+	tracer := tp.Tracer("test-tracer")
+
+	parent := tracer.Open(context.Background(), "parent")
+	defer parent.End()
+
+	originalPropagator := GetTextMapPropagator()
+	SetTextMapPropagator(propagation.TraceContext{})
+	defer SetTextMapPropagator(originalPropagator)
+
+	cmd := tracer.Command(parent.Context(), "sh", "-c", "echo $TRACEPARENT", "--password=secret")
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	traceparent := strings.TrimSpace(string(output))
+	if !strings.Contains(traceparent, parent.TraceID().String()) {
+		t.Errorf("Expected TRACEPARENT to carry trace ID %s, got %q", parent.TraceID(), traceparent)
+	}
+	if !strings.Contains(traceparent, cmd.Span().SpanID().String()) {
+		t.Errorf("Expected TRACEPARENT to carry command span ID %s, got %q", cmd.Span().SpanID(), traceparent)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+
+	attrs := make(map[Key]string)
+	for _, attr := range spans[0].Attributes {
+		attrs[attr.Key] = attr.Value.Emit()
+	}
+	if !strings.Contains(attrs[__ATTR_ARGV], "--password=***") {
+		t.Errorf("Expected argv to be redacted, got %q", attrs[__ATTR_ARGV])
+	}
+	if attrs["reply.exit_code"] != "0" {
+		t.Errorf("Expected reply.exit_code 0, got %q", attrs["reply.exit_code"])
+	}
+	if attrs[__ATTR_EVENT_STATUS_CODE] != string(PASS) {
+		t.Errorf("Expected status code %q, got %q", PASS, attrs[__ATTR_EVENT_STATUS_CODE])
+	}
+}
+
+func TestSeverityTracer_Command_InheritedContext(t *testing.T) {
+	if _, err := exec.LookPath("sh"); err != nil {
+		t.Skip("sh not available")
+	}
+
+	originalPropagator := GetTextMapPropagator()
+	SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	defer SetTextMapPropagator(originalPropagator)
+
+	// the command span is not recording and has no baggage
+	tracer := CreateSeverityTracerProvider(noop.NewTracerProvider()).Tracer("test-tracer")
+
+	cmd := tracer.Command(context.Background(), "sh", "-c", "echo \"$TRACEPARENT|$BAGGAGE\"")
+	cmd.Env = []string{
+		"TRACEPARENT=00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"BAGGAGE=tenant=stale",
+	}
+	output, err := cmd.Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := strings.TrimSpace(string(output)); v != "|" {
+		t.Errorf("Expected inherited TRACEPARENT and BAGGAGE to be dropped, got %q", v)
+	}
+}
+
+func TestCmd_Wait_NotStarted(t *testing.T) {
+	tracer := CreateSeverityTracerProvider(trace.NewTracerProvider()).Tracer("test-tracer")
+
+	cmd := tracer.Command(context.Background(), "sh", "-c", "true")
+	if err := cmd.Wait(); err == nil {
+		t.Error("Expected Wait before Start to return an error")
+	}
+	if cmd.Span() != nil {
+		t.Error("Expected no span before Start")
+	}
+}
+
+func TestSeverityTracer_ExtractFromEnv(t *testing.T) {
+	tracer := CreateSeverityTracerProvider(trace.NewTracerProvider()).Tracer("test-tracer")
+
+	originalPropagator := GetTextMapPropagator()
+	SetTextMapPropagator(propagation.TraceContext{})
+	defer SetTextMapPropagator(originalPropagator)
+
+	t.Setenv("TRACEPARENT", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+
+	span := tracer.ExtractFromEnv(context.Background(), "child-process")
+	defer span.End()
+
+	if span.TraceID() != testTraceID {
+		t.Errorf("Expected span to continue trace %s, got %s", testTraceID, span.TraceID())
+	}
+}
+
+func TestEnvCarrier(t *testing.T) {
+	carrier := NewEnvCarrier([]string{"PATH=/bin", "UBER_TRACE_ID=abc"})
+	carrier.Set("traceparent", "00-xyz")
+
+	if carrier.Get("uber-trace-id") != "abc" {
+		t.Errorf("Get(uber-trace-id): expect %q, but got %q", "abc", carrier.Get("uber-trace-id"))
+	}
+
+	expected := []string{"PATH=/bin", "TRACEPARENT=00-xyz", "UBER_TRACE_ID=abc"}
+	if !reflect.DeepEqual(carrier.Environ(), expected) {
+		t.Errorf("Environ(): expect %v, but got %v", expected, carrier.Environ())
+	}
+}
+
+func TestRedactArgv(t *testing.T) {
+	argv := []string{"--user", "admin", "--password", "secret", "--api-key=abc", "file.txt"}
+	expected := []string{"--user", "admin", "--password", "***", "--api-key=***", "file.txt"}
+
+	redacted := RedactArgv(argv)
+	if !reflect.DeepEqual(redacted, expected) {
+		t.Errorf("RedactArgv(): expect %v, but got %v", expected, redacted)
+	}
+	if argv[3] != "secret" {
+		t.Error("Expected RedactArgv not to modify its input")
+	}
+}
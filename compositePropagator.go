@@ -0,0 +1,112 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	__ENV_OTEL_PROPAGATORS = "OTEL_PROPAGATORS"
+
+	__DEFAULT_PROPAGATORS = "tracecontext,baggage"
+)
+
+var (
+	_ propagation.TextMapPropagator = CompositePropagator(nil)
+)
+
+// CompositePropagator injects with all of its propagators, but extracts the
+// span context from the first propagator which yields one. Later
+// propagators can still contribute baggage. It suits services migrating
+// between propagation formats.
+type CompositePropagator []propagation.TextMapPropagator
+
+func NewCompositePropagator(propagators ...propagation.TextMapPropagator) CompositePropagator {
+	return CompositePropagator(propagators)
+}
+
+// NewPropagator creates a CompositePropagator from propagator names, as used
+// by the OTEL_PROPAGATORS environment variable. The supported names are
+// tracecontext, baggage, b3, b3multi, jaeger and none.
+func NewPropagator(names ...string) (CompositePropagator, error) {
+	var propagators []propagation.TextMapPropagator
+	for _, name := range names {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "tracecontext":
+			propagators = append(propagators, propagation.TraceContext{})
+		case "baggage":
+			propagators = append(propagators, propagation.Baggage{})
+		case "b3":
+			propagators = append(propagators, B3Propagator{SingleHeader: true})
+		case "b3multi":
+			propagators = append(propagators, B3Propagator{})
+		case "jaeger":
+			propagators = append(propagators, JaegerPropagator{})
+		case "none", "":
+			// skip
+		default:
+			return nil, fmt.Errorf("unsupported propagator %q", name)
+		}
+	}
+	return NewCompositePropagator(propagators...), nil
+}
+
+// PropagatorFromEnv creates a CompositePropagator from the OTEL_PROPAGATORS
+// environment variable, defaults to "tracecontext,baggage".
+func PropagatorFromEnv() (CompositePropagator, error) {
+	names, ok := os.LookupEnv(__ENV_OTEL_PROPAGATORS)
+	if !ok || len(strings.TrimSpace(names)) == 0 {
+		names = __DEFAULT_PROPAGATORS
+	}
+	return NewPropagator(strings.Split(names, ",")...)
+}
+
+// Inject implements propagation.TextMapPropagator
+func (p CompositePropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	for _, v := range p {
+		v.Inject(ctx, carrier)
+	}
+}
+
+// Extract implements propagation.TextMapPropagator
+func (p CompositePropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	var (
+		origin    = trace.SpanContextFromContext(ctx)
+		extracted bool
+	)
+	for _, v := range p {
+		next := v.Extract(ctx, carrier)
+		if sc := trace.SpanContextFromContext(next); !sc.Equal(origin) {
+			if extracted {
+				// keep the span context extracted first
+				next = trace.ContextWithSpan(next, trace.SpanFromContext(ctx))
+			}
+			extracted = true
+		}
+		ctx = next
+	}
+	return ctx
+}
+
+// Fields implements propagation.TextMapPropagator
+func (p CompositePropagator) Fields() []string {
+	var (
+		fields []string
+		seen   = make(map[string]struct{})
+	)
+	for _, v := range p {
+		for _, field := range v.Fields() {
+			if _, ok := seen[field]; !ok {
+				seen[field] = struct{}{}
+				fields = append(fields, field)
+			}
+		}
+	}
+	return fields
+}
@@ -0,0 +1,56 @@
+package trace
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ContextWithTags returns a copy of ctx carrying tags in addition to the tags
+// already carried by ctx. Every span started beneath ctx by SeverityTracer
+// carries these tags. Unlike baggage, they never leave the process.
+func ContextWithTags(ctx context.Context, tags ...KeyValue) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if len(tags) == 0 {
+		return ctx
+	}
+
+	inherited := TagsFromContext(ctx)
+	merged := make([]KeyValue, 0, len(inherited)+len(tags))
+	merged = append(merged, inherited...)
+	merged = append(merged, tags...)
+	return context.WithValue(ctx, __CONTEXT_TAGS_KEY, merged)
+}
+
+// TagsFromContext returns the tags carried by ctx.
+func TagsFromContext(ctx context.Context) []KeyValue {
+	if v, ok := ctx.Value(__CONTEXT_TAGS_KEY).([]KeyValue); ok {
+		return v
+	}
+	return nil
+}
+
+// WithoutContextTags returns a copy of ctx so the next span started from it
+// does not carry the tags of ctx. Spans started beneath that span carry them
+// again.
+func WithoutContextTags(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, __CONTEXT_SKIP_TAGS_KEY, true)
+}
+
+func applyContextTags(ctx context.Context, opts []trace.SpanStartOption) (context.Context, []trace.SpanStartOption) {
+	if skip, _ := ctx.Value(__CONTEXT_SKIP_TAGS_KEY).(bool); skip {
+		return context.WithValue(ctx, __CONTEXT_SKIP_TAGS_KEY, false), opts
+	}
+
+	tags := TagsFromContext(ctx)
+	if len(tags) == 0 {
+		return ctx, opts
+	}
+	// the explicit attributes in opts take precedence
+	return ctx, append([]trace.SpanStartOption{trace.WithAttributes(tags...)}, opts...)
+}
@@ -0,0 +1,84 @@
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestContextWithTags(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+	tracer := tp.Tracer("test-tracer")
+
+	ctx := ContextWithTags(context.Background(), Key("tenant_id").String("acme"))
+	ctx = ContextWithTags(ctx, Key("job_id").Int(42))
+
+	if n := len(TagsFromContext(ctx)); n != 2 {
+		t.Fatalf("Expected 2 tags in context, got %d", n)
+	}
+
+	root := tracer.Open(ctx, "root")
+	child := tracer.Start(root.Context(), "child")
+	linked := tracer.Link(ctx, root.Link(), "linked")
+	extracted := tracer.Extract(ctx, propagation.MapCarrier{}, "extracted")
+	for _, sp := range []*SeveritySpan{extracted, linked, child, root} {
+		sp.End()
+	}
+
+	for _, sp := range exporter.GetSpans() {
+		attrs := make(map[Key]string)
+		for _, attr := range sp.Attributes {
+			attrs[attr.Key] = attr.Value.Emit()
+		}
+		if attrs["tenant_id"] != "acme" || attrs["job_id"] != "42" {
+			t.Errorf("Expected span %q to carry context tags, got %v", sp.Name, attrs)
+		}
+	}
+}
+
+func TestContextWithTags_ExplicitAttributesWin(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+
+	ctx := ContextWithTags(context.Background(), Key("tenant_id").String("acme"))
+	span := tp.Tracer("test-tracer").Start(ctx, "span",
+		oteltrace.WithAttributes(Key("tenant_id").String("override")))
+	span.End()
+
+	for _, attr := range exporter.GetSpans()[0].Attributes {
+		if attr.Key == "tenant_id" && attr.Value.AsString() != "override" {
+			t.Errorf("Expected explicit attribute to win, got %q", attr.Value.AsString())
+		}
+	}
+}
+
+func TestWithoutContextTags(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+	tracer := tp.Tracer("test-tracer")
+
+	ctx := ContextWithTags(context.Background(), Key("tenant_id").String("acme"))
+	skipped := tracer.Start(WithoutContextTags(ctx), "skipped")
+	grandchild := tracer.Start(skipped.Context(), "grandchild")
+	grandchild.End()
+	skipped.End()
+
+	spans := exporter.GetSpans()
+	if len(spans[1].Attributes) != 0 {
+		t.Errorf("Expected span %q to carry no tags, got %v", spans[1].Name, spans[1].Attributes)
+	}
+	if len(spans[0].Attributes) != 1 {
+		t.Errorf("Expected span %q to inherit tags again, got %v", spans[0].Name, spans[0].Attributes)
+	}
+}
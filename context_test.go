@@ -286,4 +286,94 @@ func TestCompositeSpanExtractor_Integration(t *testing.T) {
 	if result != span1 {
 		t.Error("Expected composite to return span from first non-nil extractor")
 	}
-}
\ No newline at end of file
+}
+
+func TestSpanFromContext_BorrowedSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := trace.NewTracerProvider(trace.WithSpanProcessor(recorder))
+
+	// span owned by another instrumentation
+	ctx, owner := tp.Tracer("other-library").Start(context.Background(), "owner")
+
+	span := SpanFromContext(ctx)
+	if !span.IsBorrowed() {
+		t.Fatal("Expected span wrapping the OpenTelemetry span to be borrowed")
+	}
+	span.End()
+	if n := len(recorder.Ended()); n != 0 {
+		t.Fatalf("Expected borrowed span not to be ended, got %d ended spans", n)
+	}
+
+	// a new wrapper on every call, which is never flushed nor ended
+	SpanFromContext(ctx).Info("routed")
+	SpanFromContext(ctx).Warning("slow")
+
+	owner.End()
+	if n := len(recorder.Ended()); n != 1 {
+		t.Fatalf("Expected owner to end the span, got %d ended spans", n)
+	}
+	if n := len(recorder.Ended()[0].Events()); n != 2 {
+		t.Errorf("Expected events to be written immediately, got %d events", n)
+	}
+}
+
+func TestSpanFromContext_BorrowedSpan_Stream(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := trace.NewTracerProvider(trace.WithSpanProcessor(recorder))
+
+	ctx, owner := tp.Tracer("other-library").Start(context.Background(), "owner")
+	defer owner.End()
+
+	// opt in to tag the events before they are written
+	span := SpanFromContext(ctx)
+	span.Stream(0)
+	span.Info("routed").Tags(Key("route").String("/users"))
+	span.Info("handled").Vars(map[string]any{"status": 200})
+
+	started := recorder.Started()[0]
+	if n := len(started.Events()); n != 1 {
+		t.Fatalf("Expected the previous event to be written, got %d events", n)
+	}
+
+	span.End()
+	if n := len(recorder.Ended()); n != 0 {
+		t.Fatalf("Expected borrowed span not to be ended, got %d ended spans", n)
+	}
+
+	events := started.Events()
+	if len(events) != 2 {
+		t.Fatalf("Expected End to write the pending event, got %d events", len(events))
+	}
+	for i, key := range []Key{"route", "vars.status"} {
+		var found bool
+		for _, attr := range events[i].Attributes {
+			if attr.Key == key {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("event #%d: expect attribute %q, got %v", i, key, events[i].Attributes)
+		}
+	}
+}
+
+func TestCreateSeveritySpan_OwnsSpan(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := trace.NewTracerProvider(trace.WithSpanProcessor(recorder))
+
+	ctx, _ := tp.Tracer("other-library").Start(context.Background(), "owner")
+
+	span := CreateSeveritySpan(ctx)
+	if span.IsBorrowed() {
+		t.Fatal("Expected CreateSeveritySpan not to borrow the span")
+	}
+	span.Info("handled")
+	span.End()
+
+	if n := len(recorder.Ended()); n != 1 {
+		t.Fatalf("Expected End to end the span, got %d ended spans", n)
+	}
+	if n := len(recorder.Ended()[0].Events()); n != 1 {
+		t.Errorf("Expected 1 event, got %d", n)
+	}
+}
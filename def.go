@@ -7,6 +7,7 @@ import (
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/propagation"
 	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
 	"go.opentelemetry.io/otel/trace"
@@ -34,6 +35,7 @@ const (
 
 	PASS  ReplyCode = ReplyCode("pass")
 	FAIL  ReplyCode = ReplyCode("fail")
+	ABORT ReplyCode = ReplyCode("abort")
 	UNSET ReplyCode = ReplyCode("")
 )
 
@@ -68,6 +70,22 @@ const (
 	__ATTR_MESSAGE_ID     attribute.Key = "message_id"
 	__ATTR_TOPIC          attribute.Key = "topic"
 	__ATTR_STREAM         attribute.Key = "stream"
+
+	__ATTR_QUEUE_WAIT_TIME attribute.Key = "queue.wait_time_ms"
+
+	__ATTR_EXCEPTION_STACKTRACE attribute.Key = "exception.stacktrace"
+
+	__ATTR_COMMAND attribute.Key = "command"
+
+	__ATTR_TRACE_TRUST attribute.Key = "trace.trust"
+	__ATTR_REQUEST_ID  attribute.Key = "request_id"
+
+	__ATTR_CHECKPOINT attribute.Key = "checkpoint"
+
+	__ATTR_ERROR_TYPE        attribute.Key = "error.type"
+	__ATTR_ERROR_CODE        attribute.Key = "error.code"
+	__ATTR_ERROR_CAUSES      attribute.Key = "error.causes"
+	__ATTR_ERROR_CAUSE_TYPES attribute.Key = "error.cause_types"
 )
 
 const (
@@ -100,7 +118,25 @@ const (
 )
 
 const (
-	__CONTEXT_SEVERITY_SPAN_KEY ctxSpanKeyType = 0
+	// StatusCodeUnset is the default status code.
+	StatusCodeUnset = codes.Unset
+	// StatusCodeError indicates the operation contains an error.
+	StatusCodeError = codes.Error
+	// StatusCodeOk indicates the operation has been validated by an
+	// application developer or operator to have completed successfully.
+	StatusCodeOk = codes.Ok
+)
+
+const (
+	__TRACER_NAME = "github.com/Bofry/trace"
+
+	__CONTEXT_SEVERITY_SPAN_KEY  ctxSpanKeyType = 0
+	__CONTEXT_TAGS_KEY           ctxSpanKeyType = 1
+	__CONTEXT_SKIP_TAGS_KEY      ctxSpanKeyType = 2
+	__CONTEXT_REQUEST_ID_KEY     ctxSpanKeyType = 3
+	__CONTEXT_DISABLE_POLICY_KEY ctxSpanKeyType = 4
+	__CONTEXT_SUPPRESS_KEY       ctxSpanKeyType = 5
+	__CONTEXT_TRACE_RESPONSE_KEY ctxSpanKeyType = 6
 
 	// FlagsSampled is a bitmask with the sampled bit set. A SpanContext
 	// with the sampling bit set means the span is sampled.
@@ -113,6 +149,7 @@ type (
 	KeyValue   = attribute.KeyValue
 	Key        = attribute.Key
 	SpanKind   = trace.SpanKind
+	StatusCode = codes.Code
 	Link       = trace.Link
 	TraceID    = trace.TraceID
 	SpanID     = trace.SpanID
@@ -149,6 +186,14 @@ type (
 	spanInjectorHolder struct {
 		v SpanInjector
 	}
+
+	traceURLBuilderHolder struct {
+		v TraceURLBuilder
+	}
+
+	diagnosticHandlerHolder struct {
+		v otel.ErrorHandler
+	}
 )
 
 var (
@@ -158,6 +203,10 @@ var (
 	globalSpanExtractor  = defaultSpanExtractor()
 	globalSpanInjector   = defaultSpanInjector()
 
+	globalTraceURLBuilder = defaultTraceURLBuilder()
+
+	globalDiagnosticHandler = defaultDiagnosticHandler()
+
 	noopSpan = trace.SpanFromContext(context.Background())
 )
 
@@ -218,6 +267,16 @@ func SetSpanInjector(injector SpanInjector) {
 	})
 }
 
+func GetTraceURLBuilder() TraceURLBuilder {
+	return globalTraceURLBuilder.Load().(traceURLBuilderHolder).v
+}
+
+func SetTraceURLBuilder(builder TraceURLBuilder) {
+	globalTraceURLBuilder.Store(traceURLBuilderHolder{
+		v: builder,
+	})
+}
+
 func Tracer(name string, opts ...trace.TracerOption) *SeverityTracer {
 	return GetTracerProvider().Tracer(name, opts...)
 }
@@ -274,11 +333,12 @@ func SpanFromContext(ctx context.Context, extractors ...SpanExtractor) *Severity
 		}
 	}
 
-	// extract span form otel trace.SpanFromContext()
+	// extract span form otel trace.SpanFromContext(), the span is owned by
+	// whoever started it
 	return &SeveritySpan{
-		span:   trace.SpanFromContext(ctx),
-		ctx:    ctx,
-		events: make([]SpanEvent, 0, 4),
+		span:     trace.SpanFromContext(ctx),
+		ctx:      ctx,
+		borrowed: true,
 	}
 }
 
@@ -331,3 +391,15 @@ func defaultSpanInjector() *atomic.Value {
 	})
 	return v
 }
+
+func defaultTraceURLBuilder() *atomic.Value {
+	v := &atomic.Value{}
+	v.Store(traceURLBuilderHolder{})
+	return v
+}
+
+func defaultDiagnosticHandler() *atomic.Value {
+	v := &atomic.Value{}
+	v.Store(diagnosticHandlerHolder{})
+	return v
+}
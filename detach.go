@@ -0,0 +1,47 @@
+package trace
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DetachContext returns a context which is never canceled and has no
+// deadline, but still carries the values of ctx and the span returned by
+// SpanFromContext(ctx). It is intended for work that outlives the request
+// which started it.
+func DetachContext(ctx context.Context, extractors ...SpanExtractor) context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+
+	span := SpanFromContext(ctx, extractors...)
+	detached := context.WithoutCancel(ctx)
+	if IsNoopSeveritySpan(span) {
+		return detached
+	}
+
+	// the span may come from a custom SpanExtractor which cannot read it
+	// from the detached context, so store it on both paths explicitly.
+	detached = trace.ContextWithSpan(detached, span.otelSpan())
+	return ContextWithSpan(detached, span)
+}
+
+// Detach starts a new root span on a detached context of ctx. The new span
+// is linked to the span in ctx instead of being its child, which suits
+// long-running follow-up work.
+func (s *SeverityTracer) Detach(
+	ctx context.Context,
+	spanName string,
+	opts ...trace.SpanStartOption) *SeveritySpan {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	link := SpanFromContext(ctx).Link()
+	if link.SpanContext.IsValid() {
+		opts = append(opts, trace.WithLinks(link))
+	}
+	return s.Open(DetachContext(ctx), spanName, opts...)
+}
@@ -0,0 +1,85 @@
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestDetachContext(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+
+	span := tp.Tracer("test-tracer").Open(context.Background(), "request")
+	defer span.End()
+
+	ctx, cancel := context.WithCancel(ContextWithSpan(span.Context(), span))
+	detached := DetachContext(ctx)
+	cancel()
+
+	if detached.Err() != nil {
+		t.Error("Expected detached context not to be canceled")
+	}
+	if _, ok := detached.Deadline(); ok {
+		t.Error("Expected detached context to have no deadline")
+	}
+	if SpanFromContext(detached) != span {
+		t.Error("Expected detached context to carry the span")
+	}
+}
+
+func TestDetachContext_CustomExtractor(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+
+	span := tp.Tracer("test-tracer").Open(context.Background(), "request")
+	defer span.End()
+
+	valueCtx := &mockValueContext{
+		Context: context.Background(),
+		values:  make(map[any]any),
+	}
+	extractor := &testSpanExtractor{span: span}
+
+	detached := DetachContext(valueCtx, extractor)
+	if SpanFromContext(detached) != span {
+		t.Error("Expected detached context to carry the span from custom extractor")
+	}
+}
+
+func TestSeverityTracer_Detach(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+
+	tracer := tp.Tracer("test-tracer")
+	span := tracer.Open(context.Background(), "request")
+	defer span.End()
+
+	ctx, cancel := context.WithCancel(span.Context())
+	followUp := tracer.Detach(ctx, "follow-up")
+	cancel()
+	followUp.End()
+
+	if followUp.Context().Err() != nil {
+		t.Error("Expected follow-up span context not to be canceled")
+	}
+	if followUp.TraceID() == span.TraceID() {
+		t.Error("Expected follow-up span to start a new trace")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	if len(spans[0].Links) != 1 || spans[0].Links[0].SpanContext.SpanID() != span.SpanID() {
+		t.Error("Expected follow-up span to link to request span")
+	}
+}
@@ -0,0 +1,82 @@
+package trace
+
+import (
+	"errors"
+	"fmt"
+	"runtime"
+
+	"go.opentelemetry.io/otel"
+)
+
+var (
+	ErrSpanEnded = errors.New("trace: span already ended")
+
+	_ error = new(SpanMisuseError)
+)
+
+// SpanMisuseError describes a call on a SeveritySpan which is already ended,
+// reported to the handler set by SetDiagnosticHandler.
+type SpanMisuseError struct {
+	Op      string
+	TraceID TraceID
+	SpanID  SpanID
+	File    string
+	Line    int
+}
+
+// Error implements error
+func (e *SpanMisuseError) Error() string {
+	return fmt.Sprintf("trace: %s called on ended span (trace_id=%s span_id=%s) at %s:%d",
+		e.Op, e.TraceID, e.SpanID, e.File, e.Line)
+}
+
+// Unwrap returns ErrSpanEnded.
+func (e *SpanMisuseError) Unwrap() error {
+	return ErrSpanEnded
+}
+
+// GetDiagnosticHandler returns the handler receiving span misuse reports, or
+// nil if diagnostics are disabled.
+func GetDiagnosticHandler() otel.ErrorHandler {
+	return globalDiagnosticHandler.Load().(diagnosticHandlerHolder).v
+}
+
+// SetDiagnosticHandler enables diagnostics, reporting a *SpanMisuseError to
+// handler whenever a SeveritySpan is ended twice, or records events, tags,
+// argv, reply or errors after End. A nil handler disables diagnostics.
+func SetDiagnosticHandler(handler otel.ErrorHandler) {
+	globalDiagnosticHandler.Store(diagnosticHandlerHolder{
+		v: handler,
+	})
+}
+
+func (s *SeveritySpan) checkEnded(op string, skip int) {
+	if GetDiagnosticHandler() == nil {
+		return
+	}
+
+	s.mu.Lock()
+	ended := s.ended
+	s.mu.Unlock()
+	if ended {
+		s.reportMisuse(op, skip+1)
+	}
+}
+
+func (s *SeveritySpan) reportMisuse(op string, skip int) {
+	handler := GetDiagnosticHandler()
+	if handler == nil {
+		return
+	}
+
+	err := &SpanMisuseError{
+		Op:      op,
+		TraceID: s.TraceID(),
+		SpanID:  s.SpanID(),
+	}
+	if _, file, line, ok := runtime.Caller(skip + 1); ok {
+		err.File = file
+		err.Line = line
+	}
+	handler.Handle(err)
+}
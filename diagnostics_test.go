@@ -0,0 +1,89 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSeveritySpan_EndIdempotent(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSpanProcessor(recorder)))
+
+	span := tp.Tracer("diagnostics-test").Start(context.Background(), "operation")
+	span.Err(errors.New("failed"))
+	span.End()
+	span.End()
+
+	if n := len(recorder.Ended()); n != 1 {
+		t.Fatalf("Expected span to be ended once, got %d", n)
+	}
+}
+
+func TestSetDiagnosticHandler(t *testing.T) {
+	var reports []*SpanMisuseError
+	SetDiagnosticHandler(otel.ErrorHandlerFunc(func(err error) {
+		var misuse *SpanMisuseError
+		if !errors.As(err, &misuse) {
+			t.Errorf("Unexpected error %v", err)
+			return
+		}
+		reports = append(reports, misuse)
+	}))
+	defer SetDiagnosticHandler(nil)
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSpanProcessor(recorder)))
+
+	span := tp.Tracer("diagnostics-test").Start(context.Background(), "operation")
+	span.End()
+	span.Info("too late")
+	span.Tags(Key("late").Bool(true))
+	span.Reply(PASS, nil)
+	span.End()
+
+	expected := []string{INFO.Name() + " event", "Tags", "Reply", "End"}
+	if len(reports) != len(expected) {
+		t.Fatalf("Expected %d reports, got %d", len(expected), len(reports))
+	}
+	for i, op := range expected {
+		report := reports[i]
+		if report.Op != op {
+			t.Errorf("report #%d: expect op %q, got %q", i, op, report.Op)
+		}
+		if filepath.Base(report.File) != "diagnostics_test.go" {
+			t.Errorf("report #%d: expect caller in diagnostics_test.go, got %s:%d", i, report.File, report.Line)
+		}
+		if report.SpanID != span.SpanID() {
+			t.Errorf("report #%d: expect span %s, got %s", i, span.SpanID(), report.SpanID)
+		}
+		if !errors.Is(report, ErrSpanEnded) {
+			t.Errorf("report #%d: expect to match ErrSpanEnded", i)
+		}
+	}
+}
+
+func TestSetDiagnosticHandler_DisabledSpan(t *testing.T) {
+	var reports int
+	SetDiagnosticHandler(otel.ErrorHandlerFunc(func(err error) {
+		reports++
+	}))
+	defer SetDiagnosticHandler(nil)
+
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+
+	span := tp.Tracer("diagnostics-test").Start(context.Background(), "operation")
+	span.Disable(true)
+	span.End()
+	span.Warning("too late")
+
+	if reports != 1 {
+		t.Errorf("Expected 1 report, got %d", reports)
+	}
+}
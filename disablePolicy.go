@@ -0,0 +1,108 @@
+package trace
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// DisableNonRecording makes the spans started beneath a disabled span or
+	// context non-recording. They carry the span context of their parent so
+	// propagation is unchanged.
+	DisableNonRecording DisablePolicy = iota + 1
+	// DisableNewRoot makes the spans started directly beneath a disabled span
+	// or context new root spans, which record as usual.
+	DisableNewRoot
+)
+
+// DisablePolicy tells how spans started beneath a disabled span or context
+// are traced.
+type DisablePolicy int8
+
+// DisableWithPolicy disables the span like Disable(true), and applies policy
+// to the spans started beneath it, including from contexts handed out before.
+func (s *SeveritySpan) DisableWithPolicy(policy DisablePolicy) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.disabled = true
+	s.disablePolicy = policy
+	s.ctx = context.WithValue(s.ctx, __CONTEXT_DISABLE_POLICY_KEY, policy)
+}
+
+// ContextWithTracingDisabled returns a copy of ctx so that spans started
+// beneath it follow policy, e.g. to disable tracing of calls made by the
+// exporter itself.
+func ContextWithTracingDisabled(ctx context.Context, policy DisablePolicy) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, __CONTEXT_DISABLE_POLICY_KEY, policy)
+}
+
+// IsTracingDisabled reports whether spans started beneath ctx are affected by
+// a disabled span or ContextWithTracingDisabled.
+func IsTracingDisabled(ctx context.Context) bool {
+	return disablePolicyFromContext(ctx) != 0
+}
+
+func disablePolicyFromContext(ctx context.Context) DisablePolicy {
+	policy, _ := ctx.Value(__CONTEXT_DISABLE_POLICY_KEY).(DisablePolicy)
+	return policy
+}
+
+// parentDisablePolicy returns the policy of the SeveritySpan carried by ctx
+// if it is disabled. ctx may be taken from the span before it is disabled.
+func parentDisablePolicy(ctx context.Context) DisablePolicy {
+	parent := severitySpanFromContext(ctx)
+	if parent == nil {
+		return 0
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() && sc.SpanID() != parent.SpanID() {
+		// ctx is beneath another span
+		return 0
+	}
+
+	parent.mu.Lock()
+	defer parent.mu.Unlock()
+	if !parent.disabled {
+		return 0
+	}
+	return parent.disablePolicy
+}
+
+// severitySpanFromContext is like SpanFromContext, but returns nil instead of
+// wrapping the OpenTelemetry span.
+func severitySpanFromContext(ctx context.Context) *SeveritySpan {
+	if span := GetSpanExtractor().Extract(ctx); span != nil {
+		return span
+	}
+	span, _ := ctx.Value(__CONTEXT_SEVERITY_SPAN_KEY).(*SeveritySpan)
+	return span
+}
+
+// applyDisablePolicy returns the span to use instead of starting one if
+// tracing is disabled as non-recording beneath ctx.
+func applyDisablePolicy(ctx context.Context, opts []trace.SpanStartOption) (context.Context, []trace.SpanStartOption, *SeveritySpan) {
+	policy := disablePolicyFromContext(ctx)
+	if policy == 0 {
+		policy = parentDisablePolicy(ctx)
+	}
+
+	switch policy {
+	case DisableNonRecording:
+		sc := trace.SpanContextFromContext(ctx)
+		ctx = trace.ContextWithSpanContext(ctx, sc)
+		return ctx, opts, &SeveritySpan{
+			span:     trace.SpanFromContext(ctx),
+			ctx:      ctx,
+			disabled: true,
+		}
+	case DisableNewRoot:
+		// descendants of the new root are traced as usual
+		ctx = context.WithValue(ctx, __CONTEXT_DISABLE_POLICY_KEY, DisablePolicy(0))
+		return ctx, append(opts, trace.WithNewRoot()), nil
+	}
+	return ctx, opts, nil
+}
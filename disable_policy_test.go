@@ -0,0 +1,135 @@
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSeveritySpan_Disable_Descendants(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+	tracer := tp.Tracer("disable-test")
+
+	parent := tracer.Start(context.Background(), "parent")
+	parent.Disable(true)
+
+	child := tracer.Start(parent.Context(), "child")
+	grandchild := child.Start("grandchild")
+	for _, span := range []*SeveritySpan{child, grandchild} {
+		if span.otelSpan().IsRecording() {
+			t.Error("Expected descendant of a disabled span to be non-recording")
+		}
+		if span.SpanID() != parent.SpanID() {
+			t.Errorf("Expected descendant to carry span context %s, got %s", parent.SpanID(), span.SpanID())
+		}
+	}
+	grandchild.End()
+	child.End()
+	parent.End()
+
+	if n := len(exporter.GetSpans()); n != 0 {
+		t.Errorf("Expected no exported span, got %d", n)
+	}
+}
+
+func TestSeveritySpan_Disable_AfterContextHandedOut(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+	tracer := tp.Tracer("disable-test")
+
+	parent := tracer.Start(context.Background(), "parent")
+	handedOut := []context.Context{
+		parent.Context(),
+		ContextWithSpan(context.Background(), parent),
+	}
+	parent.Disable(true)
+
+	for i, ctx := range handedOut {
+		child := tracer.Start(ctx, "child")
+		if child.otelSpan().IsRecording() {
+			t.Errorf("context #%d: expect child of a disabled span to be non-recording", i)
+		}
+		grandchild := child.Start("grandchild")
+		if grandchild.otelSpan().IsRecording() {
+			t.Errorf("context #%d: expect grandchild of a disabled span to be non-recording", i)
+		}
+		grandchild.End()
+		child.End()
+	}
+	parent.End()
+
+	if n := len(exporter.GetSpans()); n != 0 {
+		t.Errorf("Expected no exported span, got %d", n)
+	}
+}
+
+func TestSeveritySpan_DisableWithPolicy_NewRoot(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+	tracer := tp.Tracer("disable-test")
+
+	parent := tracer.Start(context.Background(), "parent")
+	parent.DisableWithPolicy(DisableNewRoot)
+
+	child := tracer.Start(parent.Context(), "child")
+	grandchild := child.Start("grandchild")
+	grandchild.End()
+	child.End()
+	parent.End()
+
+	if child.TraceID() == parent.TraceID() {
+		t.Error("Expected child to start a new trace")
+	}
+	if grandchild.TraceID() != child.TraceID() {
+		t.Error("Expected grandchild to be traced beneath child")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected child and grandchild to be exported, got %d spans", len(spans))
+	}
+	if spans[1].Parent.IsValid() {
+		t.Errorf("Expected child to be a root span, got parent %s", spans[1].Parent.SpanID())
+	}
+}
+
+func TestSeveritySpan_Disable_Enable(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+	tracer := tp.Tracer("disable-test")
+
+	parent := tracer.Start(context.Background(), "parent")
+	parent.Disable(true)
+	parent.Disable(false)
+
+	if IsTracingDisabled(parent.Context()) {
+		t.Error("Expected tracing to be enabled again")
+	}
+	tracer.Start(parent.Context(), "child").End()
+	parent.End()
+
+	if n := len(exporter.GetSpans()); n != 2 {
+		t.Errorf("Expected 2 exported spans, got %d", n)
+	}
+}
+
+func TestContextWithTracingDisabled(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+
+	ctx := ContextWithTracingDisabled(context.Background(), DisableNonRecording)
+	if !IsTracingDisabled(ctx) {
+		t.Fatal("Expected tracing to be disabled")
+	}
+
+	span := tp.Tracer("disable-test").Start(ctx, "export")
+	span.Info("ignored")
+	span.End()
+
+	if n := len(exporter.GetSpans()); n != 0 {
+		t.Errorf("Expected no exported span, got %d", n)
+	}
+}
@@ -0,0 +1,62 @@
+package trace
+
+import (
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/propagation"
+)
+
+var (
+	_ propagation.TextMapCarrier = EnvCarrier(nil)
+)
+
+// EnvCarrier is a propagation.TextMapCarrier over environment variables.
+// The propagation keys are mapped to variable names by upper-casing them and
+// replacing '-' with '_', so traceparent is carried as TRACEPARENT.
+type EnvCarrier map[string]string
+
+// NewEnvCarrier creates an EnvCarrier from environ in the "key=value" form
+// returned by os.Environ.
+func NewEnvCarrier(environ []string) EnvCarrier {
+	carrier := make(EnvCarrier, len(environ))
+	for _, v := range environ {
+		if k, v, ok := strings.Cut(v, "="); ok {
+			carrier[k] = v
+		}
+	}
+	return carrier
+}
+
+// Get implements propagation.TextMapCarrier
+func (c EnvCarrier) Get(key string) string {
+	return c[envVarName(key)]
+}
+
+// Set implements propagation.TextMapCarrier
+func (c EnvCarrier) Set(key string, value string) {
+	c[envVarName(key)] = value
+}
+
+// Keys implements propagation.TextMapCarrier
+func (c EnvCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, strings.ReplaceAll(strings.ToLower(k), "_", "-"))
+	}
+	return keys
+}
+
+// Environ returns the variables in the "key=value" form, sorted by key.
+func (c EnvCarrier) Environ() []string {
+	environ := make([]string, 0, len(c))
+	for k, v := range c {
+		environ = append(environ, k+"="+v)
+	}
+	sort.Strings(environ)
+	return environ
+}
+
+func envVarName(key string) string {
+	return strings.ReplaceAll(strings.ToUpper(key), "-", "_")
+}
@@ -0,0 +1,116 @@
+package trace
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Envelope carries a payload together with the span context of its sender,
+// so the trace can be continued across channels and in-memory queues.
+type Envelope[T any] struct {
+	Payload T
+
+	link     Link
+	queuedAt time.Time
+}
+
+// NewEnvelope wraps payload with the span stored in ctx.
+func NewEnvelope[T any](ctx context.Context, payload T, attrs ...KeyValue) Envelope[T] {
+	if ctx == nil {
+		return Envelope[T]{Payload: payload}
+	}
+	if span := severitySpanFromContext(ctx); span != nil {
+		return NewEnvelopeFromSpan(span, payload, attrs...)
+	}
+	return newEnvelope(trace.SpanContextFromContext(ctx), payload, attrs)
+}
+
+// NewEnvelopeFromSpan wraps payload with the span context of span. The
+// optional attrs are attached to the Link used by Envelope.Link.
+func NewEnvelopeFromSpan[T any](span *SeveritySpan, payload T, attrs ...KeyValue) Envelope[T] {
+	if span == nil || IsNoopSeveritySpan(span) {
+		return Envelope[T]{Payload: payload}
+	}
+
+	return newEnvelope(span.otelSpan().SpanContext(), payload, attrs)
+}
+
+func newEnvelope[T any](sc trace.SpanContext, payload T, attrs []KeyValue) Envelope[T] {
+	if !sc.IsValid() {
+		return Envelope[T]{Payload: payload}
+	}
+	return Envelope[T]{
+		Payload: payload,
+		link: Link{
+			SpanContext: sc,
+			Attributes:  attrs,
+		},
+		queuedAt: time.Now(),
+	}
+}
+
+// HasSpanContext reports whether the envelope captured a valid span context.
+func (e Envelope[T]) HasSpanContext() bool {
+	return e.link.SpanContext.IsValid()
+}
+
+// SpanContext returns the span context captured from the sender.
+func (e Envelope[T]) SpanContext() trace.SpanContext {
+	return e.link.SpanContext
+}
+
+// QueuedAt returns the time the envelope was created. It is zero when no
+// span context was captured.
+func (e Envelope[T]) QueuedAt() time.Time {
+	return e.queuedAt
+}
+
+// Start starts a child span of the sender's span on the receiving side.
+func (e Envelope[T]) Start(
+	ctx context.Context,
+	tracer *SeverityTracer,
+	spanName string,
+	opts ...trace.SpanStartOption) *SeveritySpan {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if !e.HasSpanContext() {
+		return tracer.Start(ctx, spanName, opts...)
+	}
+
+	ctx = trace.ContextWithSpanContext(ctx, e.link.SpanContext)
+	span := tracer.Start(ctx, spanName, opts...)
+	e.recordWaitTime(span)
+	return span
+}
+
+// Link starts a span on the receiving side which is linked to the sender's
+// span.
+func (e Envelope[T]) Link(
+	ctx context.Context,
+	tracer *SeverityTracer,
+	spanName string,
+	opts ...trace.SpanStartOption) *SeveritySpan {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if !e.HasSpanContext() {
+		return tracer.Start(ctx, spanName, opts...)
+	}
+
+	opts = append(opts, trace.WithLinks(e.link))
+	span := tracer.Start(ctx, spanName, opts...)
+	e.recordWaitTime(span)
+	return span
+}
+
+func (e Envelope[T]) recordWaitTime(span *SeveritySpan) {
+	wait := time.Since(e.queuedAt)
+	span.Tags(
+		__ATTR_QUEUE_WAIT_TIME.Float64(float64(wait) / float64(time.Millisecond)),
+	)
+}
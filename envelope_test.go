@@ -0,0 +1,104 @@
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestEnvelope_Start(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+
+	tracer := tp.Tracer("test-tracer")
+	producer := tracer.Open(context.Background(), "producer")
+
+	ch := make(chan Envelope[string], 1)
+	ch <- NewEnvelope(ContextWithSpan(producer.Context(), producer), "payload")
+	producer.End()
+
+	env := <-ch
+	if env.Payload != "payload" {
+		t.Errorf("Envelope.Payload: expect %q, but got %q", "payload", env.Payload)
+	}
+	if !env.HasSpanContext() {
+		t.Fatal("Expected envelope to capture span context")
+	}
+
+	consumer := env.Start(context.Background(), tracer, "consumer")
+	consumer.End()
+
+	if consumer.TraceID() != producer.TraceID() {
+		t.Error("Expected consumer span to share the producer trace ID")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+	if spans[1].Parent.SpanID() != producer.SpanID() {
+		t.Error("Expected consumer span to be a child of producer span")
+	}
+
+	var found bool
+	for _, attr := range spans[1].Attributes {
+		if attr.Key == __ATTR_QUEUE_WAIT_TIME {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected attribute %q on consumer span", __ATTR_QUEUE_WAIT_TIME)
+	}
+}
+
+func TestEnvelope_Link(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+
+	tracer := tp.Tracer("test-tracer")
+	producer := tracer.Open(context.Background(), "producer")
+	env := NewEnvelopeFromSpan(producer, 42, Key("queue").String("jobs"))
+	producer.End()
+
+	consumer := env.Link(context.Background(), tracer, "consumer")
+	consumer.End()
+
+	if consumer.TraceID() == producer.TraceID() {
+		t.Error("Expected linked consumer span to start a new trace")
+	}
+
+	spans := exporter.GetSpans()
+	links := spans[1].Links
+	if len(links) != 1 {
+		t.Fatalf("Expected 1 link, got %d", len(links))
+	}
+	if links[0].SpanContext.SpanID() != producer.SpanID() {
+		t.Error("Expected link to reference the producer span")
+	}
+}
+
+func TestEnvelope_NoopSpan(t *testing.T) {
+	env := NewEnvelope(context.Background(), "payload")
+	if env.HasSpanContext() {
+		t.Error("Expected envelope from noop span to carry no span context")
+	}
+	if !env.QueuedAt().IsZero() {
+		t.Error("Expected envelope from noop span to skip the timestamp")
+	}
+}
+
+func TestEnvelope_NoopSpan_NoAllocs(t *testing.T) {
+	ctx := context.Background()
+	allocs := testing.AllocsPerRun(100, func() {
+		_ = NewEnvelope(ctx, "payload")
+	})
+	if allocs != 0 {
+		t.Errorf("Expected NewEnvelope without span to allocate nothing, got %v allocs", allocs)
+	}
+}
@@ -0,0 +1,142 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+const (
+	__ERROR_CAUSE_LIMIT = 16
+)
+
+type (
+	// SeverityError is implemented by errors declaring the severity
+	// SeveritySpan.Err records them with, instead of ERR.
+	SeverityError interface {
+		error
+		Severity() Severity
+	}
+
+	// CodedError is implemented by errors carrying a code, recorded by
+	// SeveritySpan.Err as the error.code attribute.
+	CodedError interface {
+		error
+		Code() string
+	}
+
+	// ErrorClassifier reports whether err is expected. Expected errors are
+	// not recorded by SeveritySpan.Err.
+	ErrorClassifier func(err error) bool
+)
+
+// IgnoreCanceled is an ErrorClassifier treating context.Canceled as
+// expected.
+func IgnoreCanceled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+// SetErrorClassifier sets the classifier skipping expected errors passed to
+// SeveritySpan.Err on the spans started by the provider's tracers. It should
+// be called before the provider is used.
+func (p *SeverityTracerProvider) SetErrorClassifier(classifier ErrorClassifier) {
+	p.errorClassifier = classifier
+}
+
+func (s *SeveritySpan) isExpectedError(err error) bool {
+	if s.tracer == nil || s.tracer.provider == nil {
+		return false
+	}
+	classifier := s.tracer.provider.errorClassifier
+	return classifier != nil && classifier(err)
+}
+
+func errorSeverity(err error) Severity {
+	var v SeverityError
+	if errors.As(err, &v) {
+		return v.Severity()
+	}
+	return ERR
+}
+
+func errorAttributes(err error) []KeyValue {
+	attrs := []KeyValue{
+		__ATTR_EVENT_SEVERITY.String(errorSeverity(err).Name()),
+	}
+
+	var coded CodedError
+	if errors.As(err, &coded) {
+		attrs = append(attrs, __ATTR_ERROR_CODE.String(coded.Code()))
+	}
+
+	causes := errorCauses(err)
+	attrs = append(attrs, __ATTR_ERROR_TYPE.String(errorType(err, causes)))
+	if len(causes) > 0 {
+		var (
+			messages = make([]string, len(causes))
+			types    = make([]string, len(causes))
+		)
+		for i, cause := range causes {
+			messages[i] = cause.Error()
+			types[i] = fmt.Sprintf("%T", cause)
+		}
+		attrs = append(attrs,
+			__ATTR_ERROR_CAUSES.StringSlice(messages),
+			__ATTR_ERROR_CAUSE_TYPES.StringSlice(types),
+		)
+	}
+	return attrs
+}
+
+// errorType returns the type of the first error in the chain which is not an
+// anonymous wrapper such as *fmt.wrapError, so it names the concrete error.
+func errorType(err error, causes []error) string {
+	for _, v := range append([]error{err}, causes...) {
+		if _, ok := v.(*TracedError); ok {
+			continue
+		}
+		switch typ := fmt.Sprintf("%T", v); typ {
+		case "*fmt.wrapError", "*fmt.wrapErrors", "*errors.joinError":
+		default:
+			return typ
+		}
+	}
+	return fmt.Sprintf("%T", err)
+}
+
+// errorCauses returns the errors wrapped by err, depth-first, following both
+// Unwrap() error and Unwrap() []error.
+func errorCauses(err error) []error {
+	var (
+		causes []error
+		walk   func(err error)
+	)
+	walk = func(err error) {
+		for _, cause := range unwrapError(err) {
+			if len(causes) == __ERROR_CAUSE_LIMIT {
+				return
+			}
+			causes = append(causes, cause)
+			walk(cause)
+		}
+	}
+	walk(err)
+	return causes
+}
+
+func unwrapError(err error) []error {
+	var wrapped []error
+	switch v := err.(type) {
+	case interface{ Unwrap() error }:
+		if cause := v.Unwrap(); cause != nil {
+			wrapped = []error{cause}
+		}
+	case interface{ Unwrap() []error }:
+		for _, cause := range v.Unwrap() {
+			if cause != nil {
+				wrapped = append(wrapped, cause)
+			}
+		}
+	}
+	return wrapped
+}
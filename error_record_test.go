@@ -0,0 +1,126 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type domainError struct {
+	code string
+}
+
+func (e *domainError) Error() string      { return "domain error " + e.code }
+func (e *domainError) Code() string       { return e.code }
+func (e *domainError) Severity() Severity { return WARN }
+
+func eventAttributes(attrs []attribute.KeyValue) map[attribute.Key]attribute.Value {
+	m := make(map[attribute.Key]attribute.Value, len(attrs))
+	for _, attr := range attrs {
+		m[attr.Key] = attr.Value
+	}
+	return m
+}
+
+func TestSeveritySpan_Err_Chain(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+
+	span := tp.Tracer("error-test").Start(context.Background(), "operation")
+	root := errors.New("connection reset")
+	err := fmt.Errorf("query users: %w", errors.Join(root, &domainError{code: "E42"}))
+	span.Err(err)
+	span.End()
+
+	events := exporter.GetSpans()[0].Events
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event, got %d", len(events))
+	}
+	attrs := eventAttributes(events[0].Attributes)
+
+	if v := attrs[__ATTR_ERROR_TYPE].AsString(); v != "*errors.errorString" {
+		t.Errorf("error.type: expect %q, got %q", "*errors.errorString", v)
+	}
+	if v := attrs[__ATTR_ERROR_CODE].AsString(); v != "E42" {
+		t.Errorf("error.code: expect %q, got %q", "E42", v)
+	}
+	if v := attrs[__ATTR_EVENT_SEVERITY].AsString(); v != WARN.Name() {
+		t.Errorf("event.severity: expect %q, got %q", WARN.Name(), v)
+	}
+
+	expectedTypes := []string{"*errors.joinError", "*errors.errorString", "*trace.domainError"}
+	types := attrs[__ATTR_ERROR_CAUSE_TYPES].AsStringSlice()
+	if fmt.Sprint(types) != fmt.Sprint(expectedTypes) {
+		t.Errorf("error.cause_types: expect %v, got %v", expectedTypes, types)
+	}
+	causes := attrs[__ATTR_ERROR_CAUSES].AsStringSlice()
+	if len(causes) != 3 || causes[1] != root.Error() {
+		t.Errorf("error.causes: unexpected %v", causes)
+	}
+}
+
+func TestSeveritySpan_Err_Default(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+
+	span := tp.Tracer("error-test").Start(context.Background(), "operation")
+	span.Err(errors.New("failed"))
+	span.End()
+
+	attrs := eventAttributes(exporter.GetSpans()[0].Events[0].Attributes)
+	if v := attrs[__ATTR_EVENT_SEVERITY].AsString(); v != ERR.Name() {
+		t.Errorf("event.severity: expect %q, got %q", ERR.Name(), v)
+	}
+	if _, ok := attrs[__ATTR_ERROR_CAUSES]; ok {
+		t.Error("Expected no error.causes for an unwrapped error")
+	}
+}
+
+func TestSeveritySpan_Err_WrappedType(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+
+	_, err := os.Open("/nonexistent/config.yaml")
+	if err == nil {
+		t.Fatal("Expected os.Open to fail")
+	}
+
+	span := tp.Tracer("error-test").Start(context.Background(), "operation")
+	span.Err(fmt.Errorf("load config: %w", err))
+	span.End()
+
+	attrs := eventAttributes(exporter.GetSpans()[0].Events[0].Attributes)
+	if v := attrs[__ATTR_ERROR_TYPE].AsString(); v != "*fs.PathError" {
+		t.Errorf("error.type: expect %q, got %q", "*fs.PathError", v)
+	}
+	expectedTypes := []string{"*fs.PathError", "syscall.Errno"}
+	if types := attrs[__ATTR_ERROR_CAUSE_TYPES].AsStringSlice(); fmt.Sprint(types) != fmt.Sprint(expectedTypes) {
+		t.Errorf("error.cause_types: expect %v, got %v", expectedTypes, types)
+	}
+}
+
+func TestSeverityTracerProvider_SetErrorClassifier(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+	tp.SetErrorClassifier(IgnoreCanceled)
+
+	span := tp.Tracer("error-test").Start(context.Background(), "operation")
+	span.Err(fmt.Errorf("wait: %w", context.Canceled))
+	span.End()
+
+	stub := exporter.GetSpans()[0]
+	if len(stub.Events) != 0 {
+		t.Errorf("Expected expected error not to be recorded, got %d events", len(stub.Events))
+	}
+	for _, attr := range stub.Attributes {
+		if attr.Key == __ATTR_ERROR {
+			t.Error("Expected span not to be marked as error")
+		}
+	}
+}
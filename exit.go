@@ -0,0 +1,101 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	__FLUSH_ON_EXIT_TIMEOUT = 5 * time.Second
+
+	__EXIT_REASON_RETURN = "process exit"
+)
+
+// FlushOnExit aborts the in-flight spans of the global provider and exports
+// them before the process exits, waiting at most 5 seconds. If the goroutine
+// is panicking, the panic is recorded as the abort reason and raised again.
+// It must be deferred directly at the top of main:
+//
+//	func main() {
+//		defer trace.FlushOnExit()
+//		...
+//	}
+//
+// In-flight tracking must be enabled on the provider, see
+// SeverityTracerProvider.EnableInflightTracking. Deferred functions do not
+// run on os.Exit or log.Fatal; use Exit instead.
+func FlushOnExit() {
+	if r := recover(); r != nil {
+		abortOnExit(fmt.Sprintf("panic: %v", r), __FLUSH_ON_EXIT_TIMEOUT)
+		panic(r)
+	}
+	abortOnExit(__EXIT_REASON_RETURN, __FLUSH_ON_EXIT_TIMEOUT)
+}
+
+// FlushOnExitWithTimeout is like FlushOnExit, but waits at most timeout.
+func FlushOnExitWithTimeout(timeout time.Duration) {
+	if r := recover(); r != nil {
+		abortOnExit(fmt.Sprintf("panic: %v", r), timeout)
+		panic(r)
+	}
+	abortOnExit(__EXIT_REASON_RETURN, timeout)
+}
+
+// Exit aborts the in-flight spans of the global provider like FlushOnExit,
+// then calls os.Exit with code.
+func Exit(code int) {
+	abortOnExit(fmt.Sprintf("exit status %d", code), __FLUSH_ON_EXIT_TIMEOUT)
+	os.Exit(code)
+}
+
+// HandleExitSignals aborts the in-flight spans of the global provider when
+// one of sigs is received, waiting at most timeout for the export, then
+// raises the signal again with its default behavior. SIGINT and SIGTERM are
+// handled if sigs is empty. The returned stop function stops handling.
+func HandleExitSignals(timeout time.Duration, sigs ...os.Signal) (stop func()) {
+	if len(sigs) == 0 {
+		sigs = []os.Signal{os.Interrupt, syscall.SIGTERM}
+	}
+
+	var (
+		ch   = make(chan os.Signal, 1)
+		done = make(chan struct{})
+		once sync.Once
+	)
+	signal.Notify(ch, sigs...)
+
+	go func() {
+		select {
+		case sig := <-ch:
+			abortOnExit("signal: "+sig.String(), timeout)
+
+			signal.Reset(sigs...)
+			if p, err := os.FindProcess(os.Getpid()); err == nil {
+				if err = p.Signal(sig); err == nil {
+					return
+				}
+			}
+			os.Exit(1)
+		case <-done:
+		}
+	}()
+
+	return func() {
+		once.Do(func() {
+			signal.Stop(ch)
+			close(done)
+		})
+	}
+}
+
+func abortOnExit(reason string, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	GetTracerProvider().Abort(ctx, reason)
+}
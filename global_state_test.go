@@ -221,7 +221,9 @@ func TestGlobalSpanExtractor_CustomExtractor(t *testing.T) {
 
 	// Create custom extractor
 	customExtractor := &testSpanExtractor{span: testSpan}
+	originalGlobalExtractor := GetSpanExtractor()
 	SetSpanExtractor(customExtractor)
+	defer SetSpanExtractor(originalGlobalExtractor)
 
 	// Test that it returns our custom span
 	ctx := context.Background()
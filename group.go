@@ -0,0 +1,103 @@
+package trace
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Go runs fn in a new goroutine within a child span of the span in ctx,
+// started with the tracer of that span. A returned error is recorded with
+// Err. A panic is recorded as an EMERG event, the span is ended and the panic
+// is raised again.
+func Go(ctx context.Context, spanName string, fn SpanFunc, opts ...trace.SpanStartOption) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	tr := SpanFromContext(ctx).childTracer()
+	span := tr.Start(ctx, spanName, opts...)
+	go runSpanFunc(span, fn, true)
+}
+
+// Group is a collection of goroutines working on subtasks of a common task,
+// like errgroup.Group. Each subtask runs within its own child span.
+type Group struct {
+	ctx      context.Context
+	cancel   context.CancelCauseFunc
+	tracer   *SeverityTracer
+	detached bool
+	link     Link
+
+	wg      sync.WaitGroup
+	errOnce sync.Once
+	err     error
+}
+
+// NewGroup returns a new Group and an associated context derived from ctx.
+// The derived context is canceled the first time a subtask returns an error,
+// panics, or when Wait returns. The subtasks are started with the tracer of
+// the span in ctx.
+func NewGroup(ctx context.Context) (*Group, context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	g := &Group{
+		ctx:    ctx,
+		cancel: cancel,
+		tracer: SpanFromContext(ctx).childTracer(),
+	}
+	return g, ctx
+}
+
+// NewDetachedGroup is like NewGroup, but the subtasks are not canceled when
+// ctx is canceled. Each subtask starts a new root span linked to the span in
+// ctx instead of a child span.
+func NewDetachedGroup(ctx context.Context) (*Group, context.Context) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	link := SpanFromContext(ctx).Link()
+	g, ctx := NewGroup(DetachContext(ctx))
+	g.detached = true
+	g.link = link
+	return g, ctx
+}
+
+// Go runs fn in a new goroutine within a span named spanName. A panic is
+// recorded as an EMERG event and reported by Wait as a *PanicError.
+func (g *Group) Go(spanName string, fn SpanFunc, opts ...trace.SpanStartOption) {
+	var span *SeveritySpan
+	if g.detached {
+		if g.link.SpanContext.IsValid() {
+			opts = append(opts, trace.WithLinks(g.link))
+		}
+		span = g.tracer.Open(g.ctx, spanName, opts...)
+	} else {
+		span = g.tracer.Start(g.ctx, spanName, opts...)
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+
+		if err := runSpanFunc(span, fn, false); err != nil {
+			g.errOnce.Do(func() {
+				g.err = err
+				g.cancel(err)
+			})
+		}
+	}()
+}
+
+// Wait blocks until all subtasks have returned, then returns the first
+// non-nil error (if any) from them.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel(g.err)
+	return g.err
+}
@@ -0,0 +1,161 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestGo(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+
+	// a non-global provider
+	parent := tp.Tracer("test-tracer").Open(context.Background(), "parent")
+	defer parent.End()
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	Go(parent.Context(), "background", func(ctx context.Context, span *SeveritySpan) error {
+		defer wg.Done()
+		if SpanFromContext(ctx) != span {
+			t.Error("Expected ctx to carry the goroutine span")
+		}
+		return errors.New("failed")
+	})
+	wg.Wait()
+
+	// the span is ended right after fn returns
+	deadline := time.Now().Add(time.Second)
+	for len(exporter.GetSpans()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(time.Millisecond)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	if spans[0].Parent.SpanID() != parent.SpanID() {
+		t.Error("Expected goroutine span to be a child of parent span")
+	}
+	if spans[0].Events[0].Name != "exception" {
+		t.Errorf("Expected returned error to be recorded, got event %q", spans[0].Events[0].Name)
+	}
+}
+
+func TestGroup_Wait(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+
+	// a non-global provider
+	parent := tp.Tracer("test-tracer").Open(context.Background(), "parent")
+	defer parent.End()
+
+	expectedErr := errors.New("task failed")
+
+	g, ctx := NewGroup(parent.Context())
+	g.Go("ok", func(ctx context.Context, span *SeveritySpan) error {
+		return nil
+	})
+	g.Go("failed", func(ctx context.Context, span *SeveritySpan) error {
+		return expectedErr
+	})
+	err := g.Wait()
+	if err != expectedErr {
+		t.Errorf("Group.Wait(): expect %v, but got %v", expectedErr, err)
+	}
+	if ctx.Err() == nil {
+		t.Error("Expected group context to be canceled after Wait")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+	for _, sp := range spans {
+		if sp.Parent.SpanID() != parent.SpanID() {
+			t.Errorf("Expected span %q to be a child of parent span", sp.Name)
+		}
+	}
+}
+
+func TestGroup_Panic(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+
+	parent := tp.Tracer("test-tracer").Open(context.Background(), "parent")
+	defer parent.End()
+
+	g, _ := NewGroup(parent.Context())
+	g.Go("panic", func(ctx context.Context, span *SeveritySpan) error {
+		panic("boom")
+	})
+	err := g.Wait()
+
+	var perr *PanicError
+	if !errors.As(err, &perr) {
+		t.Fatalf("Expected *PanicError, got %v", err)
+	}
+	if perr.Value != "boom" {
+		t.Errorf("PanicError.Value: expect %v, but got %v", "boom", perr.Value)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	if len(spans[0].Events) == 0 {
+		t.Fatal("Expected panic event to be recorded")
+	}
+	for _, attr := range spans[0].Events[0].Attributes {
+		if attr.Key == __ATTR_EVENT_SEVERITY && attr.Value.AsString() != EMERG.Name() {
+			t.Errorf("Expected panic event severity %q, got %q", EMERG.Name(), attr.Value.AsString())
+		}
+	}
+}
+
+func TestDetachedGroup(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+
+	parent := tp.Tracer("test-tracer").Open(context.Background(), "parent")
+	defer parent.End()
+
+	ctx, cancel := context.WithCancel(parent.Context())
+	cancel()
+
+	g, gctx := NewDetachedGroup(ctx)
+	if gctx.Err() != nil {
+		t.Error("Expected detached group context not to be canceled by parent")
+	}
+	g.Go("detached", func(ctx context.Context, span *SeveritySpan) error {
+		return nil
+	})
+	if err := g.Wait(); err != nil {
+		t.Errorf("Group.Wait(): expect nil, but got %v", err)
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	if spans[0].SpanContext.TraceID() == parent.TraceID() {
+		t.Error("Expected detached span to start a new trace")
+	}
+	if len(spans[0].Links) != 1 || spans[0].Links[0].SpanContext.SpanID() != parent.SpanID() {
+		t.Error("Expected detached span to link to parent span")
+	}
+}
@@ -0,0 +1,103 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"weak"
+)
+
+type inflightRegistry struct {
+	mu    sync.Mutex
+	spans map[weak.Pointer[SeveritySpan]]struct{}
+}
+
+func newInflightRegistry() *inflightRegistry {
+	return &inflightRegistry{
+		spans: make(map[weak.Pointer[SeveritySpan]]struct{}),
+	}
+}
+
+// EnableInflightTracking keeps a registry of the spans started by the
+// provider's tracers which are not ended yet, so that Abort, FlushOnExit and
+// HandleExitSignals can flush their pending events. The registry only holds
+// weak references, spans dropped without End are not kept alive.
+func (p *SeverityTracerProvider) EnableInflightTracking() {
+	p.inflight.CompareAndSwap(nil, newInflightRegistry())
+}
+
+// DisableInflightTracking stops tracking in-flight spans.
+func (p *SeverityTracerProvider) DisableInflightTracking() {
+	p.inflight.Store(nil)
+}
+
+// InflightSpans returns the number of tracked spans which are not ended yet.
+func (p *SeverityTracerProvider) InflightSpans() int {
+	r := p.inflight.Load()
+	if r == nil {
+		return 0
+	}
+	return len(r.snapshot())
+}
+
+// Abort flushes the pending events of every in-flight span and ends it with
+// the ABORT reply code and a CRIT event describing reason, then calls
+// ForceFlush and Shutdown on the provider. ctx bounds the time spent
+// exporting.
+func (p *SeverityTracerProvider) Abort(ctx context.Context, reason string) error {
+	if r := p.inflight.Load(); r != nil {
+		for _, span := range r.snapshot() {
+			span.abort(reason)
+		}
+	}
+
+	return errors.Join(
+		p.ForceFlush(ctx),
+		p.Shutdown(ctx),
+	)
+}
+
+func (r *inflightRegistry) track(span *SeveritySpan) {
+	wp := weak.Make(span)
+
+	r.mu.Lock()
+	r.spans[wp] = struct{}{}
+	r.mu.Unlock()
+
+	span.inflight = r
+	runtime.AddCleanup(span, r.remove, wp)
+}
+
+func (r *inflightRegistry) untrack(span *SeveritySpan) {
+	r.remove(weak.Make(span))
+}
+
+func (r *inflightRegistry) remove(wp weak.Pointer[SeveritySpan]) {
+	r.mu.Lock()
+	delete(r.spans, wp)
+	r.mu.Unlock()
+}
+
+func (r *inflightRegistry) snapshot() []*SeveritySpan {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	spans := make([]*SeveritySpan, 0, len(r.spans))
+	for wp := range r.spans {
+		if span := wp.Value(); span != nil {
+			spans = append(spans, span)
+		}
+	}
+	return spans
+}
+
+func (s *SeveritySpan) abort(reason string) {
+	if len(reason) > 0 {
+		s.Crit("span aborted: %s", reason)
+	}
+	s.mu.Lock()
+	s.replyCode = ABORT
+	s.mu.Unlock()
+	s.End()
+}
@@ -0,0 +1,94 @@
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSeverityTracerProvider_Abort(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSpanProcessor(recorder)))
+	tp.EnableInflightTracking()
+
+	tracer := tp.Tracer("inflight-test")
+	ended := tracer.Start(context.Background(), "ended")
+	ended.End()
+
+	open := tracer.Start(context.Background(), "open")
+	open.Info("pending event")
+
+	if n := tp.InflightSpans(); n != 1 {
+		t.Fatalf("Expected 1 in-flight span, got %d", n)
+	}
+
+	if err := tp.Abort(context.Background(), "test"); err != nil {
+		t.Fatal(err)
+	}
+	if n := tp.InflightSpans(); n != 0 {
+		t.Errorf("Expected no in-flight span after Abort, got %d", n)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 ended spans, got %d", len(spans))
+	}
+	aborted := spans[1]
+	if aborted.Name() != "open" {
+		t.Fatalf("Expected span %q to be aborted, got %q", "open", aborted.Name())
+	}
+	if len(aborted.Events()) != 2 {
+		t.Errorf("Expected pending and abort events, got %d events", len(aborted.Events()))
+	}
+	var status string
+	for _, attr := range aborted.Attributes() {
+		if attr.Key == __ATTR_EVENT_STATUS_CODE {
+			status = attr.Value.AsString()
+		}
+	}
+	if status != string(ABORT) {
+		t.Errorf("Expected status code %q, got %q", ABORT, status)
+	}
+}
+
+func TestFlushOnExit_Panic(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSpanProcessor(recorder)))
+	tp.EnableInflightTracking()
+
+	original := GetTracerProvider()
+	SetTracerProvider(tp)
+	defer SetTracerProvider(original)
+
+	var recovered any
+	func() {
+		defer func() {
+			recovered = recover()
+		}()
+		defer FlushOnExit()
+
+		span := Tracer("inflight-test").Start(context.Background(), "main")
+		span.Info("before panic")
+		panic("boom")
+	}()
+
+	if recovered != "boom" {
+		t.Errorf("Expected panic to be raised again, got %v", recovered)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 ended span, got %d", len(spans))
+	}
+	var message string
+	for _, attr := range spans[0].Events()[1].Attributes {
+		if attr.Key == __ATTR_EVENT_MESSAGE {
+			message = attr.Value.AsString()
+		}
+	}
+	if message != "span aborted: panic: boom" {
+		t.Errorf("Unexpected abort message %q", message)
+	}
+}
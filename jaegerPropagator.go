@@ -0,0 +1,141 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	__JAEGER_HEADER         = "uber-trace-id"
+	__JAEGER_BAGGAGE_PREFIX = "uberctx-"
+
+	__JAEGER_FLAG_SAMPLED = 0x01
+	__JAEGER_FLAG_DEBUG   = 0x02
+)
+
+var (
+	_ propagation.TextMapPropagator = JaegerPropagator{}
+)
+
+// JaegerPropagator propagates span context in the Jaeger uber-trace-id
+// format and baggage in uberctx-* headers.
+type JaegerPropagator struct{}
+
+// Inject implements propagation.TextMapPropagator
+func (JaegerPropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	sc := trace.SpanContextFromContext(ctx)
+	if sc.IsValid() {
+		var flags int
+		if sc.IsSampled() {
+			flags = __JAEGER_FLAG_SAMPLED
+		}
+		carrier.Set(__JAEGER_HEADER, fmt.Sprintf("%s:%s:0:%x",
+			sc.TraceID().String(),
+			sc.SpanID().String(),
+			flags))
+	}
+
+	for _, m := range baggage.FromContext(ctx).Members() {
+		carrier.Set(__JAEGER_BAGGAGE_PREFIX+m.Key(), url.QueryEscape(m.Value()))
+	}
+}
+
+// Extract implements propagation.TextMapPropagator
+func (JaegerPropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	if bag, ok := extractJaegerBaggage(ctx, carrier); ok {
+		ctx = baggage.ContextWithBaggage(ctx, bag)
+	}
+
+	header := carrier.Get(__JAEGER_HEADER)
+	if len(header) == 0 {
+		return ctx
+	}
+	sc, ok := parseJaegerHeader(header)
+	if !ok {
+		return ctx
+	}
+	return trace.ContextWithRemoteSpanContext(ctx, sc)
+}
+
+// Fields implements propagation.TextMapPropagator
+func (JaegerPropagator) Fields() []string {
+	return []string{__JAEGER_HEADER}
+}
+
+func parseJaegerHeader(header string) (trace.SpanContext, bool) {
+	if v, err := url.QueryUnescape(header); err == nil {
+		header = v
+	}
+
+	parts := strings.Split(header, ":")
+	if len(parts) != 4 {
+		return trace.SpanContext{}, false
+	}
+
+	traceID, err := trace.TraceIDFromHex(leftPadHex(parts[0], 32))
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+	spanID, err := trace.SpanIDFromHex(leftPadHex(parts[1], 16))
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+	flags, err := strconv.ParseUint(parts[3], 16, 8)
+	if err != nil {
+		return trace.SpanContext{}, false
+	}
+
+	var traceFlags trace.TraceFlags
+	if flags&(__JAEGER_FLAG_SAMPLED|__JAEGER_FLAG_DEBUG) != 0 {
+		traceFlags = FlagsSampled
+	}
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: traceFlags,
+		Remote:     true,
+	})
+	return sc, sc.IsValid()
+}
+
+func extractJaegerBaggage(ctx context.Context, carrier propagation.TextMapCarrier) (baggage.Baggage, bool) {
+	var (
+		bag   = baggage.FromContext(ctx)
+		found bool
+	)
+	for _, k := range carrier.Keys() {
+		if len(k) <= len(__JAEGER_BAGGAGE_PREFIX) ||
+			!strings.EqualFold(k[:len(__JAEGER_BAGGAGE_PREFIX)], __JAEGER_BAGGAGE_PREFIX) {
+			continue
+		}
+
+		value, err := url.QueryUnescape(carrier.Get(k))
+		if err != nil {
+			continue
+		}
+		member, err := baggage.NewMemberRaw(strings.ToLower(k[len(__JAEGER_BAGGAGE_PREFIX):]), value)
+		if err != nil {
+			continue
+		}
+		if v, err := bag.SetMember(member); err == nil {
+			bag = v
+			found = true
+		}
+	}
+	return bag, found
+}
+
+func leftPadHex(v string, size int) string {
+	if len(v) >= size {
+		return v
+	}
+	return strings.Repeat("0", size-len(v)) + v
+}
@@ -0,0 +1,224 @@
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+)
+
+const (
+	// LeakOpenTooLong reports a span which stays open beyond the threshold.
+	LeakOpenTooLong LeakReason = iota
+	// LeakCollected reports a span which is garbage-collected without End.
+	LeakCollected
+
+	__LEAK_DETECTION_MIN_INTERVAL = 10 * time.Millisecond
+)
+
+var (
+	leakReasonNames = []string{
+		LeakOpenTooLong: "open too long",
+		LeakCollected:   "collected without End",
+	}
+)
+
+type (
+	// LeakReason tells why a span is reported as leaked.
+	LeakReason int8
+
+	// LeakHandler is called for every span reported as leaked.
+	LeakHandler func(span OpenSpan, reason LeakReason)
+
+	// OpenSpan describes a span which has been started but not ended.
+	OpenSpan struct {
+		Name      string    `json:"name"`
+		TraceID   TraceID   `json:"trace_id"`
+		SpanID    SpanID    `json:"span_id"`
+		StartTime time.Time `json:"start_time"`
+		Stack     string    `json:"stack"`
+	}
+)
+
+func (r LeakReason) Name() string {
+	if r < 0 || int(r) >= len(leakReasonNames) {
+		return ""
+	}
+	return leakReasonNames[r]
+}
+
+type spanRecord struct {
+	info     OpenSpan
+	reported bool
+}
+
+type leakDetector struct {
+	threshold time.Duration
+	handler   LeakHandler
+
+	mu      sync.Mutex
+	records map[*spanRecord]struct{}
+
+	stop chan struct{}
+}
+
+func newLeakDetector(threshold time.Duration, handler LeakHandler) *leakDetector {
+	if handler == nil {
+		handler = defaultLeakHandler
+	}
+	d := &leakDetector{
+		threshold: threshold,
+		handler:   handler,
+		records:   make(map[*spanRecord]struct{}),
+		stop:      make(chan struct{}),
+	}
+	if threshold > 0 {
+		go d.run()
+	}
+	return d
+}
+
+// EnableLeakDetection tracks every span started by the provider's tracers
+// until it is ended, together with the stack which started it. Spans still
+// open after threshold, or garbage-collected without End, are reported to
+// handler. A nil handler reports to the OpenTelemetry error handler, and a
+// zero threshold only reports collected spans. It is meant for debugging,
+// since capturing stacks is expensive.
+func (p *SeverityTracerProvider) EnableLeakDetection(threshold time.Duration, handler LeakHandler) {
+	d := newLeakDetector(threshold, handler)
+	if prev := p.leakDetector.Swap(d); prev != nil {
+		prev.close()
+	}
+}
+
+// DisableLeakDetection stops tracking spans.
+func (p *SeverityTracerProvider) DisableLeakDetection() {
+	if prev := p.leakDetector.Swap(nil); prev != nil {
+		prev.close()
+	}
+}
+
+// OpenSpans returns the spans tracked by leak detection which are not ended
+// yet, ordered by start time.
+func (p *SeverityTracerProvider) OpenSpans() []OpenSpan {
+	d := p.leakDetector.Load()
+	if d == nil {
+		return nil
+	}
+	return d.openSpans()
+}
+
+// LeakDetectionHandler returns a http.Handler serving OpenSpans as JSON, to
+// be mounted on a debug endpoint.
+func (p *SeverityTracerProvider) LeakDetectionHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		spans := p.OpenSpans()
+		if spans == nil {
+			spans = []OpenSpan{}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(spans)
+	})
+}
+
+func (d *leakDetector) track(span *SeveritySpan, spanName string) {
+	rec := &spanRecord{
+		info: OpenSpan{
+			Name:      spanName,
+			TraceID:   span.TraceID(),
+			SpanID:    span.SpanID(),
+			StartTime: time.Now(),
+			Stack:     string(debug.Stack()),
+		},
+	}
+
+	d.mu.Lock()
+	d.records[rec] = struct{}{}
+	d.mu.Unlock()
+
+	span.leakDetector = d
+	span.leakRecord = rec
+	runtime.AddCleanup(span, d.collect, rec)
+}
+
+func (d *leakDetector) untrack(rec *spanRecord) {
+	d.mu.Lock()
+	delete(d.records, rec)
+	d.mu.Unlock()
+}
+
+func (d *leakDetector) collect(rec *spanRecord) {
+	d.mu.Lock()
+	_, open := d.records[rec]
+	delete(d.records, rec)
+	d.mu.Unlock()
+
+	if open {
+		d.handler(rec.info, LeakCollected)
+	}
+}
+
+func (d *leakDetector) openSpans() []OpenSpan {
+	d.mu.Lock()
+	spans := make([]OpenSpan, 0, len(d.records))
+	for rec := range d.records {
+		spans = append(spans, rec.info)
+	}
+	d.mu.Unlock()
+
+	sort.Slice(spans, func(i, j int) bool {
+		return spans[i].StartTime.Before(spans[j].StartTime)
+	})
+	return spans
+}
+
+func (d *leakDetector) check() {
+	var leaked []OpenSpan
+
+	deadline := time.Now().Add(-d.threshold)
+	d.mu.Lock()
+	for rec := range d.records {
+		if !rec.reported && rec.info.StartTime.Before(deadline) {
+			rec.reported = true
+			leaked = append(leaked, rec.info)
+		}
+	}
+	d.mu.Unlock()
+
+	for _, span := range leaked {
+		d.handler(span, LeakOpenTooLong)
+	}
+}
+
+func (d *leakDetector) run() {
+	interval := d.threshold / 2
+	if interval < __LEAK_DETECTION_MIN_INTERVAL {
+		interval = __LEAK_DETECTION_MIN_INTERVAL
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			d.check()
+		case <-d.stop:
+			return
+		}
+	}
+}
+
+func (d *leakDetector) close() {
+	close(d.stop)
+}
+
+func defaultLeakHandler(span OpenSpan, reason LeakReason) {
+	otel.Handle(fmt.Errorf("trace: span %q (trace_id=%s span_id=%s) leaked: %s; started at:\n%s",
+		span.Name, span.TraceID, span.SpanID, reason.Name(), span.Stack))
+}
@@ -0,0 +1,162 @@
+package trace
+
+import (
+	"context"
+	"encoding/json"
+	"net/http/httptest"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+type leakRecorder struct {
+	mu     sync.Mutex
+	spans  []OpenSpan
+	reason []LeakReason
+}
+
+func (r *leakRecorder) handle(span OpenSpan, reason LeakReason) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.spans = append(r.spans, span)
+	r.reason = append(r.reason, reason)
+}
+
+func (r *leakRecorder) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.spans)
+}
+
+func TestLeakDetection_OpenTooLong(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+	defer tp.DisableLeakDetection()
+
+	recorder := new(leakRecorder)
+	tp.EnableLeakDetection(20*time.Millisecond, recorder.handle)
+
+	tracer := tp.Tracer("leak-test")
+	leaked := tracer.Start(context.Background(), "leaked")
+	ended := tracer.Start(context.Background(), "ended")
+	ended.End()
+
+	if spans := tp.OpenSpans(); len(spans) != 1 || spans[0].Name != "leaked" {
+		t.Fatalf("Expected only the leaked span to be open, got %v", spans)
+	}
+
+	deadline := time.Now().Add(2 * time.Second)
+	for recorder.count() == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	// give the detector another tick to make sure a span is reported once
+	time.Sleep(50 * time.Millisecond)
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if len(recorder.spans) != 1 {
+		t.Fatalf("Expected 1 leak report, got %d", len(recorder.spans))
+	}
+	if recorder.reason[0] != LeakOpenTooLong {
+		t.Errorf("Expected reason %q, got %q", LeakOpenTooLong.Name(), recorder.reason[0].Name())
+	}
+	if recorder.spans[0].SpanID != leaked.SpanID() {
+		t.Errorf("Expected span %s, got %s", leaked.SpanID(), recorder.spans[0].SpanID)
+	}
+	if len(recorder.spans[0].Stack) == 0 {
+		t.Error("Expected creation stack to be recorded")
+	}
+}
+
+func TestLeakDetection_Collected(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+	defer tp.DisableLeakDetection()
+
+	recorder := new(leakRecorder)
+	tp.EnableLeakDetection(0, recorder.handle)
+
+	func() {
+		tp.Tracer("leak-test").Start(context.Background(), "forgotten")
+	}()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for recorder.count() == 0 && time.Now().Before(deadline) {
+		runtime.GC()
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	if len(recorder.spans) != 1 {
+		t.Fatalf("Expected 1 leak report, got %d", len(recorder.spans))
+	}
+	if recorder.reason[0] != LeakCollected {
+		t.Errorf("Expected reason %q, got %q", LeakCollected.Name(), recorder.reason[0].Name())
+	}
+	if recorder.spans[0].Name != "forgotten" {
+		t.Errorf("Expected span %q, got %q", "forgotten", recorder.spans[0].Name)
+	}
+}
+
+func TestLeakDetection_Collected_Streamed(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+	defer tp.DisableLeakDetection()
+
+	recorder := new(leakRecorder)
+	tp.EnableLeakDetection(0, recorder.handle)
+
+	func() {
+		span := tp.Tracer("leak-test").Start(context.Background(), "streamed")
+		span.Stream(time.Millisecond)
+		span.Info("connected")
+	}()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for recorder.count() == 0 && time.Now().Before(deadline) {
+		runtime.GC()
+		time.Sleep(5 * time.Millisecond)
+	}
+	if recorder.count() != 1 {
+		t.Fatalf("Expected streamed span to be reported once collected, got %d reports", recorder.count())
+	}
+}
+
+func TestLeakDetectionHandler(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+	defer tp.DisableLeakDetection()
+
+	tp.EnableLeakDetection(time.Hour, func(OpenSpan, LeakReason) {})
+
+	span := tp.Tracer("leak-test").Start(context.Background(), "pending")
+	defer span.End()
+
+	rec := httptest.NewRecorder()
+	tp.LeakDetectionHandler().ServeHTTP(rec, httptest.NewRequest("GET", "/debug/spans", nil))
+
+	var spans []struct {
+		Name    string `json:"name"`
+		TraceID string `json:"trace_id"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &spans); err != nil {
+		t.Fatal(err)
+	}
+	if len(spans) != 1 || spans[0].Name != "pending" || spans[0].TraceID != span.TraceID().String() {
+		t.Errorf("Unexpected open spans %v", spans)
+	}
+}
+
+func TestLeakReason_Name(t *testing.T) {
+	if name := LeakCollected.Name(); name != "collected without End" {
+		t.Errorf("Name(): expect %q, got %q", "collected without End", name)
+	}
+	if name := LeakReason(42).Name(); name != "" {
+		t.Errorf("Name(): expect empty name for an unknown reason, got %q", name)
+	}
+}
@@ -0,0 +1,76 @@
+package trace
+
+import (
+	"fmt"
+	"runtime/debug"
+)
+
+var (
+	_ error = new(PanicError)
+)
+
+// PanicError describes a recovered panic.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+// Error implements error
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	if err, ok := e.Value.(error); ok {
+		return err
+	}
+	return nil
+}
+
+// Recover ends the span, and if the goroutine is panicking, records the panic
+// value and stack as an EMERG event before the span is ended and raises the
+// panic again. It must be deferred directly, in place of End:
+//
+//	span := tracer.Start(ctx, "operation")
+//	defer span.Recover()
+func (s *SeveritySpan) Recover() {
+	if r := recover(); r != nil {
+		s.recordPanic(r, debug.Stack())
+		s.End()
+		panic(r)
+	}
+	s.End()
+}
+
+// RecoverError is like Recover, but converts the panic into a *PanicError
+// stored in *errp instead of raising it again.
+func (s *SeveritySpan) RecoverError(errp *error) {
+	if r := recover(); r != nil {
+		perr := s.recordPanic(r, debug.Stack())
+		if errp != nil {
+			*errp = perr
+		}
+	}
+	s.End()
+}
+
+func (s *SeveritySpan) recordPanic(r any, stack []byte) *PanicError {
+	perr := &PanicError{
+		Value: r,
+		Stack: stack,
+	}
+
+	event := s.Emerg("panic: %v", r)
+	event.Error(perr)
+	event.Tags(
+		__ATTR_ERROR.Bool(true),
+		__ATTR_EXCEPTION_STACKTRACE.String(string(stack)),
+	)
+
+	// output later
+	s.mu.Lock()
+	s.err = perr
+	s.mu.Unlock()
+	return perr
+}
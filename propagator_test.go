@@ -0,0 +1,201 @@
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+var (
+	testTraceID, _ = trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	testSpanID, _  = trace.SpanIDFromHex("00f067aa0ba902b7")
+
+	testSpanContext = trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    testTraceID,
+		SpanID:     testSpanID,
+		TraceFlags: FlagsSampled,
+	})
+)
+
+func TestJaegerPropagator(t *testing.T) {
+	member, _ := baggage.NewMember("tenant", "acme")
+	bag, _ := baggage.New(member)
+
+	ctx := trace.ContextWithSpanContext(context.Background(), testSpanContext)
+	ctx = baggage.ContextWithBaggage(ctx, bag)
+
+	carrier := make(propagation.MapCarrier)
+	JaegerPropagator{}.Inject(ctx, carrier)
+
+	expectedHeader := "4bf92f3577b34da6a3ce929d0e0e4736:00f067aa0ba902b7:0:1"
+	if carrier.Get("uber-trace-id") != expectedHeader {
+		t.Errorf("uber-trace-id: expect %q, but got %q", expectedHeader, carrier.Get("uber-trace-id"))
+	}
+	if carrier.Get("uberctx-tenant") != "acme" {
+		t.Errorf("uberctx-tenant: expect %q, but got %q", "acme", carrier.Get("uberctx-tenant"))
+	}
+
+	extracted := JaegerPropagator{}.Extract(context.Background(), carrier)
+	sc := trace.SpanContextFromContext(extracted)
+	if sc.TraceID() != testTraceID || sc.SpanID() != testSpanID || !sc.IsSampled() || !sc.IsRemote() {
+		t.Errorf("Extract(): unexpected span context %v", sc)
+	}
+	if v := baggage.FromContext(extracted).Member("tenant").Value(); v != "acme" {
+		t.Errorf("baggage tenant: expect %q, but got %q", "acme", v)
+	}
+}
+
+func TestJaegerPropagator_ShortTraceID(t *testing.T) {
+	carrier := propagation.MapCarrier{
+		"uber-trace-id": "a3ce929d0e0e4736%3A0f067aa0ba902b7%3A0%3A3",
+	}
+	sc := trace.SpanContextFromContext(JaegerPropagator{}.Extract(context.Background(), carrier))
+	if sc.TraceID().String() != "0000000000000000a3ce929d0e0e4736" {
+		t.Errorf("TraceID: got %s", sc.TraceID())
+	}
+	if sc.SpanID() != testSpanID {
+		t.Errorf("SpanID: got %s", sc.SpanID())
+	}
+	if !sc.IsSampled() {
+		t.Error("Expected debug flag to imply sampled")
+	}
+}
+
+func TestJaegerPropagator_Malformed(t *testing.T) {
+	for _, header := range []string{
+		"",
+		"abc",
+		"xyz:00f067aa0ba902b7:0:1",
+		"4bf92f3577b34da6a3ce929d0e0e4736:0:0:1",
+		"4bf92f3577b34da6a3ce929d0e0e4736:00f067aa0ba902b7:0:zz",
+	} {
+		carrier := propagation.MapCarrier{"uber-trace-id": header}
+		sc := trace.SpanContextFromContext(JaegerPropagator{}.Extract(context.Background(), carrier))
+		if sc.IsValid() {
+			t.Errorf("Extract(%q): expect invalid span context", header)
+		}
+	}
+}
+
+func TestB3Propagator(t *testing.T) {
+	ctx := trace.ContextWithSpanContext(context.Background(), testSpanContext)
+
+	testCases := []struct {
+		name       string
+		propagator B3Propagator
+		fields     int
+	}{
+		{"SingleHeader", B3Propagator{SingleHeader: true}, 1},
+		{"MultipleHeader", B3Propagator{}, 3},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			carrier := make(propagation.MapCarrier)
+			tc.propagator.Inject(ctx, carrier)
+			if len(carrier) != tc.fields {
+				t.Errorf("Expected %d headers, got %d", tc.fields, len(carrier))
+			}
+
+			// extract accepts both encodings
+			sc := trace.SpanContextFromContext(B3Propagator{}.Extract(context.Background(), carrier))
+			if sc.TraceID() != testTraceID || sc.SpanID() != testSpanID || !sc.IsSampled() {
+				t.Errorf("Extract(): unexpected span context %v", sc)
+			}
+		})
+	}
+}
+
+func TestB3Propagator_SingleHeaderVariants(t *testing.T) {
+	testCases := []struct {
+		header  string
+		valid   bool
+		sampled bool
+	}{
+		{"4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7", true, false},
+		{"a3ce929d0e0e4736-00f067aa0ba902b7-d", true, true},
+		{"4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-1-05e3ac9a4f6e3b90", true, true},
+		{"0", false, false},
+		{"4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-x", false, false},
+	}
+
+	for _, tc := range testCases {
+		carrier := propagation.MapCarrier{"b3": tc.header}
+		sc := trace.SpanContextFromContext(B3Propagator{}.Extract(context.Background(), carrier))
+		if sc.IsValid() != tc.valid {
+			t.Errorf("Extract(%q): expect valid %v, but got %v", tc.header, tc.valid, sc.IsValid())
+		}
+		if sc.IsSampled() != tc.sampled {
+			t.Errorf("Extract(%q): expect sampled %v, but got %v", tc.header, tc.sampled, sc.IsSampled())
+		}
+	}
+}
+
+func TestCompositePropagator_ExtractFirst(t *testing.T) {
+	propagator, err := NewPropagator("tracecontext", "baggage", "b3", "jaeger")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	carrier := propagation.MapCarrier{
+		"uber-trace-id": "4bf92f3577b34da6a3ce929d0e0e4736:00f067aa0ba902b7:0:1",
+		"b3":            "5bf92f3577b34da6a3ce929d0e0e4736-10f067aa0ba902b7-1",
+		"baggage":       "tenant=acme",
+	}
+	ctx := propagator.Extract(context.Background(), carrier)
+
+	sc := trace.SpanContextFromContext(ctx)
+	if sc.TraceID().String() != "5bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("Expected span context from b3 to win, got trace ID %s", sc.TraceID())
+	}
+	if v := baggage.FromContext(ctx).Member("tenant").Value(); v != "acme" {
+		t.Errorf("baggage tenant: expect %q, but got %q", "acme", v)
+	}
+
+	// inject writes every format
+	out := make(propagation.MapCarrier)
+	propagator.Inject(ctx, out)
+	for _, field := range []string{"traceparent", "b3", "uber-trace-id", "baggage"} {
+		if len(out.Get(field)) == 0 {
+			t.Errorf("Expected %q to be injected", field)
+		}
+	}
+}
+
+func TestNewPropagator_Unsupported(t *testing.T) {
+	_, err := NewPropagator("tracecontext", "xray")
+	if err == nil {
+		t.Error("Expected error for unsupported propagator")
+	}
+}
+
+func TestPropagatorFromEnv(t *testing.T) {
+	t.Setenv("OTEL_PROPAGATORS", "b3multi, jaeger")
+
+	propagator, err := PropagatorFromEnv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(propagator) != 2 {
+		t.Errorf("Expected 2 propagators, got %d", len(propagator))
+	}
+}
+
+func TestSeverityTracer_ExtractWithCompositePropagator(t *testing.T) {
+	propagator := NewCompositePropagator(propagation.TraceContext{}, JaegerPropagator{})
+	carrier := propagation.MapCarrier{
+		"uber-trace-id": "4bf92f3577b34da6a3ce929d0e0e4736:00f067aa0ba902b7:0:1",
+	}
+
+	tracer := CreateSeverityTracerProvider(noop.NewTracerProvider()).Tracer("test-tracer")
+	span := tracer.ExtractWithPropagator(context.Background(), propagator, carrier, "extracted")
+	defer span.End()
+
+	if span.TraceID() != testTraceID {
+		t.Errorf("Expected extracted span to continue trace %s, got %s", testTraceID, span.TraceID())
+	}
+}
@@ -0,0 +1,128 @@
+package trace
+
+import (
+	"context"
+	"crypto/sha256"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	RequestIDHeader     = "x-request-id"
+	CorrelationIDHeader = "x-correlation-id"
+)
+
+var (
+	_ propagation.TextMapPropagator = RequestIDPropagator{}
+
+	defaultRequestIDHeaders = []string{
+		RequestIDHeader,
+		CorrelationIDHeader,
+	}
+)
+
+type requestID struct {
+	header string
+	value  string
+}
+
+// RequestIDPropagator propagates legacy request ID headers such as
+// X-Request-ID and X-Correlation-ID. On Extract the first non-empty header
+// is stored in the context and recorded as the request_id tag on every span
+// started beneath it, see ContextWithTags. On Inject the request ID is
+// emitted again under the header it was received from.
+//
+// It should be placed after the trace context propagators in a
+// CompositePropagator, so DeriveTraceID only applies when no trace context
+// is present.
+type RequestIDPropagator struct {
+	// Headers lists the request ID headers by priority, defaults to
+	// x-request-id and x-correlation-id.
+	Headers []string
+	// DeriveTraceID derives a deterministic remote span context from the
+	// request ID when the context has no span context yet, so every service
+	// receiving the same request ID shares the same trace ID.
+	DeriveTraceID bool
+}
+
+// ContextWithRequestID returns a copy of ctx carrying id as its request ID.
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, __CONTEXT_REQUEST_ID_KEY, requestID{value: id})
+}
+
+// RequestIDFromContext returns the request ID carried by ctx.
+func RequestIDFromContext(ctx context.Context) string {
+	if v, ok := ctx.Value(__CONTEXT_REQUEST_ID_KEY).(requestID); ok {
+		return v.value
+	}
+	return ""
+}
+
+// Inject implements propagation.TextMapPropagator
+func (p RequestIDPropagator) Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	v, ok := ctx.Value(__CONTEXT_REQUEST_ID_KEY).(requestID)
+	if !ok || len(v.value) == 0 {
+		return
+	}
+
+	header := v.header
+	if len(header) == 0 {
+		header = p.headers()[0]
+	}
+	carrier.Set(header, v.value)
+}
+
+// Extract implements propagation.TextMapPropagator
+func (p RequestIDPropagator) Extract(ctx context.Context, carrier propagation.TextMapCarrier) context.Context {
+	for _, header := range p.headers() {
+		id := carrier.Get(header)
+		if len(id) == 0 {
+			continue
+		}
+
+		ctx = context.WithValue(ctx, __CONTEXT_REQUEST_ID_KEY, requestID{
+			header: header,
+			value:  id,
+		})
+		ctx = ContextWithTags(ctx, __ATTR_REQUEST_ID.String(id))
+
+		if p.DeriveTraceID && !trace.SpanContextFromContext(ctx).IsValid() {
+			ctx = trace.ContextWithRemoteSpanContext(ctx, deriveSpanContext(id))
+		}
+		return ctx
+	}
+	return ctx
+}
+
+// Fields implements propagation.TextMapPropagator
+func (p RequestIDPropagator) Fields() []string {
+	return p.headers()
+}
+
+func (p RequestIDPropagator) headers() []string {
+	if len(p.Headers) == 0 {
+		return defaultRequestIDHeaders
+	}
+	return p.Headers
+}
+
+func deriveSpanContext(id string) trace.SpanContext {
+	var (
+		sum     = sha256.Sum256([]byte(id))
+		traceID trace.TraceID
+		spanID  trace.SpanID
+	)
+	copy(traceID[:], sum[:16])
+	copy(spanID[:], sum[16:24])
+
+	return trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: FlagsSampled,
+		Remote:     true,
+	})
+}
@@ -0,0 +1,98 @@
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestRequestIDPropagator(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+	tracer := tp.Tracer("test-tracer")
+
+	propagator := NewCompositePropagator(propagation.TraceContext{}, RequestIDPropagator{})
+	carrier := propagation.MapCarrier{
+		"x-correlation-id": "abc-123",
+	}
+
+	span := tracer.ExtractWithPropagator(context.Background(), propagator, carrier, "server")
+	span.End()
+
+	if id := RequestIDFromContext(span.Context()); id != "abc-123" {
+		t.Errorf("RequestIDFromContext(): expect %q, but got %q", "abc-123", id)
+	}
+	if span.TraceID() == testTraceID {
+		t.Error("Expected no trace context to be continued")
+	}
+
+	var recorded string
+	for _, attr := range exporter.GetSpans()[0].Attributes {
+		if attr.Key == __ATTR_REQUEST_ID {
+			recorded = attr.Value.AsString()
+		}
+	}
+	if recorded != "abc-123" {
+		t.Errorf("Expected %s attribute %q, got %q", __ATTR_REQUEST_ID, "abc-123", recorded)
+	}
+
+	// re-emit on outbound requests
+	out := make(propagation.MapCarrier)
+	tracer.InjectWithPropagator(span.Context(), propagator, out)
+	if out.Get("x-correlation-id") != "abc-123" {
+		t.Errorf("Expected x-correlation-id to be injected, got %v", out)
+	}
+	if len(out.Get("traceparent")) == 0 {
+		t.Error("Expected traceparent to be injected")
+	}
+}
+
+func TestRequestIDPropagator_DeriveTraceID(t *testing.T) {
+	propagator := NewCompositePropagator(
+		propagation.TraceContext{},
+		RequestIDPropagator{DeriveTraceID: true},
+	)
+
+	extract := func(carrier propagation.MapCarrier) oteltrace.SpanContext {
+		return oteltrace.SpanContextFromContext(propagator.Extract(context.Background(), carrier))
+	}
+
+	first := extract(propagation.MapCarrier{"x-request-id": "req-1"})
+	second := extract(propagation.MapCarrier{"x-request-id": "req-1"})
+	other := extract(propagation.MapCarrier{"x-request-id": "req-2"})
+
+	if !first.IsValid() {
+		t.Fatal("Expected derived span context to be valid")
+	}
+	if first.TraceID() != second.TraceID() {
+		t.Error("Expected derived trace ID to be deterministic")
+	}
+	if first.TraceID() == other.TraceID() {
+		t.Error("Expected different request IDs to derive different trace IDs")
+	}
+
+	// trace context takes precedence
+	sc := extract(propagation.MapCarrier{
+		"traceparent":  "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"x-request-id": "req-1",
+	})
+	if sc.TraceID() != testTraceID {
+		t.Errorf("Expected trace context to win, got trace ID %s", sc.TraceID())
+	}
+}
+
+func TestRequestIDPropagator_ContextWithRequestID(t *testing.T) {
+	ctx := ContextWithRequestID(context.Background(), "generated")
+
+	carrier := make(propagation.MapCarrier)
+	RequestIDPropagator{Headers: []string{"x-amzn-trace-id"}}.Inject(ctx, carrier)
+	if carrier.Get("x-amzn-trace-id") != "generated" {
+		t.Errorf("Expected request ID under the first configured header, got %v", carrier)
+	}
+}
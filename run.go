@@ -0,0 +1,84 @@
+package trace
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SpanFunc is a function executed within the span created for it. The ctx
+// carries span, so SpanFromContext(ctx) returns it.
+type SpanFunc func(ctx context.Context, span *SeveritySpan) error
+
+// Run runs fn within a new span started by tracer. A returned error is
+// recorded with Err, otherwise the reply code is set to PASS unless fn set
+// one. A panic is recorded as an EMERG event with its stack and raised again
+// after the span is ended. The span is always ended.
+func Run(
+	ctx context.Context,
+	tracer *SeverityTracer,
+	spanName string,
+	fn SpanFunc,
+	opts ...trace.SpanStartOption) error {
+
+	_, err := Call(ctx, tracer, spanName, func(ctx context.Context, span *SeveritySpan) (struct{}, error) {
+		return struct{}{}, fn(ctx, span)
+	}, opts...)
+	return err
+}
+
+// Call is like Run, but fn returns a value.
+func Call[T any](
+	ctx context.Context,
+	tracer *SeverityTracer,
+	spanName string,
+	fn func(ctx context.Context, span *SeveritySpan) (T, error),
+	opts ...trace.SpanStartOption) (T, error) {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	span := tracer.Start(ctx, spanName, opts...)
+	return callSpanFunc(span, fn, true)
+}
+
+func callSpanFunc[T any](
+	span *SeveritySpan,
+	fn func(ctx context.Context, span *SeveritySpan) (T, error),
+	repanic bool) (result T, err error) {
+
+	if repanic {
+		defer span.Recover()
+	} else {
+		defer span.RecoverError(&err)
+	}
+
+	ctx := ContextWithSpan(span.Context(), span)
+	result, err = fn(ctx, span)
+	if err != nil {
+		span.Err(err)
+		span.replyIfUnset(FAIL)
+	} else {
+		span.replyIfUnset(PASS)
+	}
+	return result, err
+}
+
+func runSpanFunc(span *SeveritySpan, fn SpanFunc, repanic bool) error {
+	_, err := callSpanFunc(span, func(ctx context.Context, span *SeveritySpan) (struct{}, error) {
+		return struct{}{}, fn(ctx, span)
+	}, repanic)
+	return err
+}
+
+func (s *SeveritySpan) replyIfUnset(code ReplyCode) {
+	if !s.span.IsRecording() {
+		return
+	}
+
+	s.mu.Lock()
+	if s.replyCode == UNSET {
+		s.replyCode = code
+	}
+	s.mu.Unlock()
+}
@@ -0,0 +1,126 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func spanStatusCode(t *testing.T, exporter *tracetest.InMemoryExporter, index int) string {
+	t.Helper()
+
+	spans := exporter.GetSpans()
+	if len(spans) <= index {
+		t.Fatalf("Expected at least %d spans, got %d", index+1, len(spans))
+	}
+	for _, attr := range spans[index].Attributes {
+		if attr.Key == __ATTR_EVENT_STATUS_CODE {
+			return attr.Value.AsString()
+		}
+	}
+	return ""
+}
+
+func TestRun(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+	tracer := tp.Tracer("test-tracer")
+
+	err := Run(context.Background(), tracer, "ok", func(ctx context.Context, span *SeveritySpan) error {
+		if SpanFromContext(ctx) != span {
+			t.Error("Expected ctx to carry the span")
+		}
+		span.Info("working")
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Run(): expect nil, but got %v", err)
+	}
+	if code := spanStatusCode(t, exporter, 0); code != string(PASS) {
+		t.Errorf("Expected status code %q, got %q", PASS, code)
+	}
+	if n := len(exporter.GetSpans()[0].Events); n != 1 {
+		t.Errorf("Expected 1 event, got %d", n)
+	}
+
+	expectedErr := errors.New("failed")
+	err = Run(context.Background(), tracer, "failed", func(ctx context.Context, span *SeveritySpan) error {
+		return expectedErr
+	})
+	if err != expectedErr {
+		t.Errorf("Run(): expect %v, but got %v", expectedErr, err)
+	}
+	if code := spanStatusCode(t, exporter, 1); code != __STATUS_CODE_ERROR {
+		t.Errorf("Expected status code %q, got %q", __STATUS_CODE_ERROR, code)
+	}
+
+	err = Run(context.Background(), tracer, "custom", func(ctx context.Context, span *SeveritySpan) error {
+		span.Reply(FAIL, "rejected")
+		return nil
+	})
+	if err != nil {
+		t.Errorf("Run(): expect nil, but got %v", err)
+	}
+	if code := spanStatusCode(t, exporter, 2); code != string(FAIL) {
+		t.Errorf("Expected reply code set by fn %q, got %q", FAIL, code)
+	}
+}
+
+func TestRun_Panic(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+	tracer := tp.Tracer("test-tracer")
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("Expected panic %q to be raised again, got %v", "boom", r)
+			}
+		}()
+		Run(context.Background(), tracer, "panic", func(ctx context.Context, span *SeveritySpan) error {
+			span.Info("before panic")
+			panic("boom")
+		})
+	}()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected span to be ended, got %d spans", len(spans))
+	}
+	if n := len(spans[0].Events); n != 2 {
+		t.Fatalf("Expected 2 events, got %d", n)
+	}
+
+	attrs := make(map[Key]string)
+	for _, attr := range spans[0].Events[1].Attributes {
+		attrs[attr.Key] = attr.Value.Emit()
+	}
+	if attrs[__ATTR_EVENT_SEVERITY] != EMERG.Name() {
+		t.Errorf("Expected panic event severity %q, got %q", EMERG.Name(), attrs[__ATTR_EVENT_SEVERITY])
+	}
+	if len(attrs[__ATTR_EXCEPTION_STACKTRACE]) == 0 {
+		t.Error("Expected panic event to carry the stack trace")
+	}
+	if code := spanStatusCode(t, exporter, 0); code != __STATUS_CODE_ERROR {
+		t.Errorf("Expected status code %q, got %q", __STATUS_CODE_ERROR, code)
+	}
+}
+
+func TestCall(t *testing.T) {
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider())
+	tracer := tp.Tracer("test-tracer")
+
+	v, err := Call(context.Background(), tracer, "call", func(ctx context.Context, span *SeveritySpan) (int, error) {
+		return 42, nil
+	})
+	if err != nil || v != 42 {
+		t.Errorf("Call(): expect (42, nil), but got (%v, %v)", v, err)
+	}
+}
@@ -3,6 +3,7 @@ package trace
 import (
 	"context"
 	"fmt"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -13,23 +14,65 @@ import (
 type SeveritySpan struct {
 	span      trace.Span
 	ctx       context.Context
+	kind      SpanKind
+	tracer    *SeverityTracer
 	replyCode ReplyCode
 	err       error
 
-	events []SpanEvent
-
-	disabled bool
+	mu         sync.Mutex
+	events     []SpanEvent
+	appended   int
+	checkpoint int
+	streaming  bool
+	streamStop chan struct{}
+
+	disabled      bool
+	disablePolicy DisablePolicy
+	ended         bool
+	borrowed      bool
+
+	leakDetector *leakDetector
+	leakRecord   *spanRecord
+	inflight     *inflightRegistry
 }
 
+// Disable disables the span so End does not export it, and makes the spans
+// started beneath it non-recording, see DisableWithPolicy.
+// Disable(false) enables both again.
 func (s *SeveritySpan) Disable(disabled bool) {
-	s.disabled = disabled
+	if disabled {
+		s.DisableWithPolicy(DisableNonRecording)
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.disabled = false
+	s.disablePolicy = 0
+	if disablePolicyFromContext(s.ctx) != 0 {
+		s.ctx = context.WithValue(s.ctx, __CONTEXT_DISABLE_POLICY_KEY, DisablePolicy(0))
+	}
 }
 
 func (s *SeveritySpan) IsDisabled() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	return s.disabled
 }
 
+// IsBorrowed reports whether the span wraps an OpenTelemetry span started
+// elsewhere, such as the one returned by SpanFromContext when ctx carries no
+// SeveritySpan. The events of a borrowed span are written as soon as they are
+// created, unless the span is streamed, and End does not end the underlying
+// span. Call Stream(0) on the span to tag its events before they are written.
+func (s *SeveritySpan) IsBorrowed() bool {
+	return s.borrowed
+}
+
 func (s *SeveritySpan) Context() context.Context {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	return s.ctx
 }
 
@@ -58,41 +101,116 @@ func (s *SeveritySpan) TraceState() trace.TraceState {
 }
 
 func (s *SeveritySpan) Inject(p propagation.TextMapPropagator, c propagation.TextMapCarrier) {
+	ctx := s.Context()
+	if IsInstrumentationSuppressed(ctx) {
+		return
+	}
 	if p == nil {
 		p = otel.GetTextMapPropagator()
 	}
-	p.Inject(s.ctx, c)
+	if s.tracer != nil && s.tracer.provider != nil {
+		ctx = s.tracer.provider.limitBaggage(ctx)
+	}
+	p.Inject(ctx, c)
 }
 
 func (s *SeveritySpan) Link() Link {
-	return Link(trace.LinkFromContext(s.ctx))
+	return Link(trace.LinkFromContext(s.Context()))
 }
 
 func (s *SeveritySpan) End(opts ...trace.SpanEndOption) {
-	if s.disabled {
+	if s.leakDetector != nil {
+		s.leakDetector.untrack(s.leakRecord)
+	}
+	if s.inflight != nil {
+		s.inflight.untrack(s)
+	}
+
+	if s.borrowed {
+		// never end a span owned by someone else
+		s.mu.Lock()
+		s.stopStreamLocked()
+		s.flushLocked()
+		s.mu.Unlock()
 		return
 	}
 
-	for _, e := range s.events {
-		e.Flush()
+	s.mu.Lock()
+	if s.ended {
+		s.mu.Unlock()
+		s.reportMisuse("End", 1)
+		return
 	}
+	s.ended = true
+	if s.disabled {
+		s.mu.Unlock()
+		return
+	}
+	s.stopStreamLocked()
+	s.flushLocked()
+	err, replyCode := s.err, s.replyCode
+	s.mu.Unlock()
 
-	if s.err != nil {
+	if err != nil {
 		s.span.SetAttributes(
 			__ATTR_ERROR.Bool(true),
 			__ATTR_EVENT_STATUS_CODE.String(__STATUS_CODE_ERROR),
-			__ATTR_EVENT_STATUS_DESCRIPTION.String(s.err.Error()),
+			__ATTR_EVENT_STATUS_DESCRIPTION.String(err.Error()),
 		)
-	} else if len(s.replyCode) > 0 {
+	} else if len(replyCode) > 0 {
 		s.span.SetAttributes(
-			__ATTR_EVENT_STATUS_CODE.String(string(s.replyCode)),
+			__ATTR_EVENT_STATUS_CODE.String(string(replyCode)),
 		)
 	}
 	s.span.End(opts...)
 }
 
+// EndAt is like End, but ends the span at timestamp.
+func (s *SeveritySpan) EndAt(timestamp time.Time, opts ...trace.SpanEndOption) {
+	opts = append(opts, trace.WithTimestamp(timestamp))
+	s.End(opts...)
+}
+
+// SpanKind returns the kind the span is started with, or SpanKindUnspecified
+// if the span is not started by a SeverityTracer.
+func (s *SeveritySpan) SpanKind() SpanKind {
+	return s.kind
+}
+
+// SetName renames the span, e.g. once a request is routed.
+func (s *SeveritySpan) SetName(name string) {
+	if !s.span.IsRecording() {
+		s.checkEnded("SetName", 1)
+		return
+	}
+
+	s.span.SetName(name)
+}
+
+// SetStatus sets the OpenTelemetry status of the span. The status is
+// independent of the reply code and error recorded by Reply and Err.
+func (s *SeveritySpan) SetStatus(code StatusCode, description string) {
+	if !s.span.IsRecording() {
+		s.checkEnded("SetStatus", 1)
+		return
+	}
+
+	s.span.SetStatus(code, description)
+}
+
+// AddLink links the span to another span after it is started.
+func (s *SeveritySpan) AddLink(link Link) {
+	if !s.span.IsRecording() {
+		s.checkEnded("AddLink", 1)
+		return
+	}
+
+	s.span.AddLink(link)
+}
+
 func (s *SeveritySpan) Tags(tags ...KeyValue) {
 	if !s.span.IsRecording() {
+		s.checkEnded("Tags", 1)
 		return
 	}
 
@@ -101,6 +219,7 @@ func (s *SeveritySpan) Tags(tags ...KeyValue) {
 
 func (s *SeveritySpan) Argv(v any) {
 	if !s.span.IsRecording() {
+		s.checkEnded("Argv", 1)
 		return
 	}
 
@@ -116,6 +235,7 @@ func (s *SeveritySpan) Argv(v any) {
 
 func (s *SeveritySpan) Reply(code ReplyCode, v any) {
 	if !s.span.IsRecording() {
+		s.checkEnded("Reply", 1)
 		return
 	}
 
@@ -127,52 +247,95 @@ func (s *SeveritySpan) Reply(code ReplyCode, v any) {
 	}
 
 	// output later
+	s.mu.Lock()
 	s.replyCode = code
+	s.mu.Unlock()
 }
 
 func (s *SeveritySpan) Err(err error) {
 	if !s.span.IsRecording() {
+		s.checkEnded("Err", 1)
 		return
 	}
 
-	s.span.RecordError(err, trace.WithAttributes(
-		__ATTR_EVENT_SEVERITY.String(ERR.Name()),
-	), trace.WithStackTrace(true))
+	if err != nil {
+		if s.isExpectedError(err) {
+			return
+		}
+		s.linkTracedError(err)
+		s.span.RecordError(err, trace.WithAttributes(
+			errorAttributes(err)...,
+		), trace.WithStackTrace(true))
+	}
 
 	// output later
+	s.mu.Lock()
 	s.err = err
+	s.mu.Unlock()
 }
 
 func (s *SeveritySpan) Debug(message string, v ...any) SpanEvent {
-	return s.createEvent(DEBUG, message, v...)
+	return s.createEvent(time.Time{}, DEBUG, message, v...)
 }
 
 func (s *SeveritySpan) Info(message string, v ...any) SpanEvent {
-	return s.createEvent(INFO, message, v...)
+	return s.createEvent(time.Time{}, INFO, message, v...)
 }
 
 func (s *SeveritySpan) Notice(message string, v ...any) SpanEvent {
-	return s.createEvent(NOTICE, message, v...)
+	return s.createEvent(time.Time{}, NOTICE, message, v...)
 }
 
 func (s *SeveritySpan) Warning(reason string, v ...any) SpanEvent {
-	return s.createEvent(WARN, reason, v...)
+	return s.createEvent(time.Time{}, WARN, reason, v...)
 }
 
 func (s *SeveritySpan) Crit(reason string, v ...any) SpanEvent {
-	return s.createEvent(CRIT, reason, v...)
+	return s.createEvent(time.Time{}, CRIT, reason, v...)
 }
 
 func (s *SeveritySpan) Alert(reason string, v ...any) SpanEvent {
-	return s.createEvent(ALERT, reason, v...)
+	return s.createEvent(time.Time{}, ALERT, reason, v...)
 }
 
 func (s *SeveritySpan) Emerg(reason string, v ...any) SpanEvent {
-	return s.createEvent(EMERG, reason, v...)
+	return s.createEvent(time.Time{}, EMERG, reason, v...)
+}
+
+func (s *SeveritySpan) DebugAt(timestamp time.Time, message string, v ...any) SpanEvent {
+	return s.createEvent(timestamp, DEBUG, message, v...)
+}
+
+func (s *SeveritySpan) InfoAt(timestamp time.Time, message string, v ...any) SpanEvent {
+	return s.createEvent(timestamp, INFO, message, v...)
 }
 
-func (s *SeveritySpan) createEvent(severity Severity, message string, v ...any) SpanEvent {
+func (s *SeveritySpan) NoticeAt(timestamp time.Time, message string, v ...any) SpanEvent {
+	return s.createEvent(timestamp, NOTICE, message, v...)
+}
+
+func (s *SeveritySpan) WarningAt(timestamp time.Time, reason string, v ...any) SpanEvent {
+	return s.createEvent(timestamp, WARN, reason, v...)
+}
+
+func (s *SeveritySpan) CritAt(timestamp time.Time, reason string, v ...any) SpanEvent {
+	return s.createEvent(timestamp, CRIT, reason, v...)
+}
+
+func (s *SeveritySpan) AlertAt(timestamp time.Time, reason string, v ...any) SpanEvent {
+	return s.createEvent(timestamp, ALERT, reason, v...)
+}
+
+func (s *SeveritySpan) EmergAt(timestamp time.Time, reason string, v ...any) SpanEvent {
+	return s.createEvent(timestamp, EMERG, reason, v...)
+}
+
+func (s *SeveritySpan) createEvent(timestamp time.Time, severity Severity, message string, v ...any) SpanEvent {
 	if !s.span.IsRecording() {
+		// the op is only built when it can be reported
+		if GetDiagnosticHandler() != nil {
+			s.checkEnded(severity.Name()+" event", 2)
+		}
 		return nopEventInstance
 	}
 
@@ -183,14 +346,37 @@ func (s *SeveritySpan) createEvent(severity Severity, message string, v ...any)
 		formattedMessage = fmt.Sprintf(message, v...)
 	}
 
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
 	event := &SeverityEvent{
-		timestamp: time.Now(),
+		timestamp: timestamp,
 		span:      s.span,
 		severity:  severity,
 		message:   formattedMessage,
 		tags:      make([]KeyValue, 0, 4),
 	}
+	s.mu.Lock()
+	if s.ended {
+		// a disabled span stays recording after End
+		s.mu.Unlock()
+		s.reportMisuse(severity.Name()+" event", 2)
+		return nopEventInstance
+	}
+	if s.borrowed && !s.streaming {
+		// the owner never flushes the events of this wrapper
+		s.mu.Unlock()
+		event.Flush()
+		return event
+	}
+	if s.streaming && s.streamStop == nil {
+		// flush the previous events, this one may still be tagged
+		s.flushLocked()
+	}
 	s.events = append(s.events, event)
+	s.appended++
+	s.mu.Unlock()
 	return event
 }
 
@@ -2,6 +2,7 @@ package trace
 
 import (
 	"context"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/propagation"
@@ -9,7 +10,10 @@ import (
 )
 
 type SeverityTracer struct {
-	tr trace.Tracer
+	tr       trace.Tracer
+	provider *SeverityTracerProvider
+
+	trustPolicy TrustPolicy
 }
 
 func (s *SeverityTracer) Open(
@@ -29,12 +33,45 @@ func (s *SeverityTracer) Start(
 	if ctx == nil {
 		ctx = context.Background()
 	}
+	if IsInstrumentationSuppressed(ctx) {
+		return suppressedSpan(ctx)
+	}
+	ctx, opts, disabled := applyDisablePolicy(ctx, opts)
+	if disabled != nil {
+		return disabled
+	}
+	ctx, opts = applyContextTags(ctx, opts)
 	ctx, span := s.tr.Start(ctx, spanName, opts...)
-	return &SeveritySpan{
+	sp := &SeveritySpan{
 		span:   span,
 		ctx:    ctx,
+		kind:   spanKindOf(opts),
+		tracer: s,
 		events: make([]SpanEvent, 0, 4),
 	}
+	sp.ctx = ContextWithSpan(ctx, sp)
+	trackTraceResponse(ctx, sp)
+	if s.provider != nil {
+		s.provider.promoteBaggage(sp)
+		if d := s.provider.leakDetector.Load(); d != nil {
+			d.track(sp, spanName)
+		}
+		if r := s.provider.inflight.Load(); r != nil {
+			r.track(sp)
+		}
+	}
+	return sp
+}
+
+// StartAt is like Start, but starts the span at timestamp.
+func (s *SeverityTracer) StartAt(
+	ctx context.Context,
+	timestamp time.Time,
+	spanName string,
+	opts ...trace.SpanStartOption) *SeveritySpan {
+
+	opts = append(opts, trace.WithTimestamp(timestamp))
+	return s.Start(ctx, spanName, opts...)
 }
 
 func (s *SeverityTracer) Link(
@@ -64,6 +101,9 @@ func (s *SeverityTracer) ExtractWithPropagator(
 	if propagator == nil {
 		propagator = otel.GetTextMapPropagator()
 	}
+	if s.trustPolicy != nil {
+		return s.extractWithTrustPolicy(ctx, propagator, carrier, spanName, opts...)
+	}
 	ctx = propagator.Extract(ctx, carrier)
 	return s.Start(ctx, spanName, opts...)
 }
@@ -86,7 +126,7 @@ func (s *SeverityTracer) InjectWithPropagator(
 	propagator propagation.TextMapPropagator,
 	carrier propagation.TextMapCarrier) {
 
-	if ctx == nil {
+	if ctx == nil || IsInstrumentationSuppressed(ctx) {
 		return
 	}
 
@@ -95,7 +135,11 @@ func (s *SeverityTracer) InjectWithPropagator(
 		return
 	}
 
-	propagator.Inject(sp.ctx, carrier)
+	ctx = sp.Context()
+	if s.provider != nil {
+		ctx = s.provider.limitBaggage(ctx)
+	}
+	propagator.Inject(ctx, carrier)
 }
 
 func (s *SeverityTracer) Inject(
@@ -110,3 +154,12 @@ func (s *SeverityTracer) Inject(
 func (s *SeverityTracer) otelTracer() trace.Tracer {
 	return s.tr
 }
+
+func spanKindOf(opts []trace.SpanStartOption) SpanKind {
+	cfg := trace.NewSpanStartConfig(opts...)
+	kind := cfg.SpanKind()
+	if kind == SpanKindUnspecified {
+		return SpanKindInternal
+	}
+	return kind
+}
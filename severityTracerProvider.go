@@ -4,6 +4,7 @@ import (
 	"context"
 	"net/url"
 	"strings"
+	"sync/atomic"
 
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
@@ -15,6 +16,15 @@ import (
 
 type SeverityTracerProvider struct {
 	provider trace.TracerProvider
+
+	baggageRules      []BaggageRule
+	baggageMaxMembers int
+	baggageMaxBytes   int
+
+	errorClassifier ErrorClassifier
+
+	leakDetector atomic.Pointer[leakDetector]
+	inflight     atomic.Pointer[inflightRegistry]
 }
 
 func (p *SeverityTracerProvider) TracerProvider() trace.TracerProvider {
@@ -22,6 +32,8 @@ func (p *SeverityTracerProvider) TracerProvider() trace.TracerProvider {
 }
 
 func (p *SeverityTracerProvider) Shutdown(ctx context.Context) error {
+	p.DisableLeakDetection()
+
 	switch v := p.provider.(type) {
 	case *tracesdk.TracerProvider:
 		return v.Shutdown(ctx)
@@ -29,9 +41,34 @@ func (p *SeverityTracerProvider) Shutdown(ctx context.Context) error {
 	return nil
 }
 
+func (p *SeverityTracerProvider) ForceFlush(ctx context.Context) error {
+	switch v := p.provider.(type) {
+	case *tracesdk.TracerProvider:
+		return v.ForceFlush(ctx)
+	}
+	return nil
+}
+
 func (p *SeverityTracerProvider) Tracer(name string, opts ...trace.TracerOption) *SeverityTracer {
 	tr := p.provider.Tracer(name, opts...)
-	return CreateSeverityTracer(tr)
+	t := CreateSeverityTracer(tr)
+	t.provider = p
+	return t
+}
+
+// SetBaggageRules sets the baggage members copied onto every started span as
+// attributes. It should be called before the provider is used.
+func (p *SeverityTracerProvider) SetBaggageRules(rules ...BaggageRule) {
+	p.baggageRules = rules
+}
+
+// SetBaggageLimits limits the baggage injected by SeverityTracer.Inject to
+// maxMembers members and maxBytes bytes; members over the limits are dropped.
+// Zero or negative values mean no limit. It should be called before the
+// provider is used.
+func (p *SeverityTracerProvider) SetBaggageLimits(maxMembers, maxBytes int) {
+	p.baggageMaxMembers = maxMembers
+	p.baggageMaxBytes = maxBytes
 }
 
 // OTLPProvider creates a provider using OTLP HTTP exporter
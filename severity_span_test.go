@@ -3,7 +3,10 @@ package trace
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/sdk/trace"
@@ -288,4 +291,178 @@ func TestSeveritySpan_EventChaining(t *testing.T) {
 	if event.IsRecording() {
 		t.Error("Expected event to not be recording after flush")
 	}
-}
\ No newline at end of file
+}
+func TestSeveritySpan_Recover(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+	tracer := tp.Tracer("test-tracer")
+
+	func() {
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("Expected panic %q to be raised again, got %v", "boom", r)
+			}
+		}()
+
+		span := tracer.Start(context.Background(), "test-span")
+		defer span.Recover()
+
+		span.Info("buffered event")
+		panic("boom")
+	}()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected span to be ended, got %d spans", len(spans))
+	}
+	if n := len(spans[0].Events); n != 2 {
+		t.Errorf("Expected buffered and panic events, got %d events", n)
+	}
+}
+
+func TestSeveritySpan_Recover_NoPanic(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+
+	func() {
+		span := tp.Tracer("test-tracer").Start(context.Background(), "test-span")
+		defer span.Recover()
+	}()
+
+	if n := len(exporter.GetSpans()); n != 1 {
+		t.Errorf("Expected Recover to end the span, got %d spans", n)
+	}
+}
+
+func TestSeveritySpan_RecoverError(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+
+	fn := func() (err error) {
+		span := tp.Tracer("test-tracer").Start(context.Background(), "test-span")
+		defer span.RecoverError(&err)
+
+		panic(errors.New("boom"))
+	}
+
+	err := fn()
+	var perr *PanicError
+	if !errors.As(err, &perr) {
+		t.Fatalf("Expected *PanicError, got %v", err)
+	}
+	if perr.Unwrap() == nil || perr.Unwrap().Error() != "boom" {
+		t.Errorf("Expected PanicError to unwrap the panic value, got %v", perr.Unwrap())
+	}
+	if len(perr.Stack) == 0 {
+		t.Error("Expected PanicError to carry the stack")
+	}
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected span to be ended, got %d spans", len(spans))
+	}
+	var status string
+	for _, attr := range spans[0].Attributes {
+		if attr.Key == __ATTR_EVENT_STATUS_CODE {
+			status = attr.Value.AsString()
+		}
+	}
+	if status != __STATUS_CODE_ERROR {
+		t.Errorf("Expected status code %q, got %q", __STATUS_CODE_ERROR, status)
+	}
+}
+
+func TestSeveritySpan_ConcurrentUse(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+
+	span := tp.Tracer("concurrent-test").Start(context.Background(), "fan-out")
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				span.Info("worker %d", i).Tags(Key("iteration").Int(j))
+				span.Reply(PASS, nil)
+				if j == 50 {
+					span.Err(fmt.Errorf("worker %d failed", i))
+				}
+				_ = span.Context()
+				_ = span.IsDisabled()
+			}
+		}(i)
+	}
+	wg.Wait()
+	span.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	// 800 events and 8 recorded errors
+	if n := len(spans[0].Events) + spans[0].DroppedEvents; n != 808 {
+		t.Errorf("Expected 808 events, got %d", n)
+	}
+}
+
+func TestSeveritySpan_Controls(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+	tracer := tp.Tracer("controls-test")
+
+	var (
+		start = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+		event = start.Add(time.Second)
+		end   = start.Add(2 * time.Second)
+	)
+
+	span := tracer.StartAt(context.Background(), start, "GET", WithSpanKind(SpanKindServer))
+	if span.SpanKind() != SpanKindServer {
+		t.Errorf("SpanKind(): expect %v, got %v", SpanKindServer, span.SpanKind())
+	}
+	span.SetName("GET /users/{id}")
+	span.SetStatus(StatusCodeError, "not found")
+	span.AddLink(Link{SpanContext: testSpanContext})
+	span.InfoAt(event, "routed")
+	span.EndAt(end)
+
+	spans := exporter.GetSpans()
+	if len(spans) != 1 {
+		t.Fatalf("Expected 1 span, got %d", len(spans))
+	}
+	stub := spans[0]
+	if stub.Name != "GET /users/{id}" {
+		t.Errorf("Name: expect %q, got %q", "GET /users/{id}", stub.Name)
+	}
+	if stub.Status.Code != StatusCodeError || stub.Status.Description != "not found" {
+		t.Errorf("Status: unexpected %v", stub.Status)
+	}
+	if len(stub.Links) != 1 || stub.Links[0].SpanContext.SpanID() != testSpanID {
+		t.Errorf("Links: unexpected %v", stub.Links)
+	}
+	if !stub.StartTime.Equal(start) || !stub.EndTime.Equal(end) {
+		t.Errorf("Expected span from %v to %v, got %v to %v", start, end, stub.StartTime, stub.EndTime)
+	}
+	if len(stub.Events) != 1 || !stub.Events[0].Time.Equal(event) {
+		t.Errorf("Events: unexpected %v", stub.Events)
+	}
+}
+
+func TestSeveritySpan_SpanKindDefault(t *testing.T) {
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider())
+
+	span := tp.Tracer("controls-test").Start(context.Background(), "operation")
+	defer span.End()
+
+	if span.SpanKind() != SpanKindInternal {
+		t.Errorf("SpanKind(): expect %v, got %v", SpanKindInternal, span.SpanKind())
+	}
+}
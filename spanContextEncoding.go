@@ -0,0 +1,175 @@
+package trace
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	__SPAN_CONTEXT_ENCODING_VERSION byte = 0
+
+	// version(1) + trace id(16) + span id(8) + flags(1)
+	__SPAN_CONTEXT_ENCODING_HEADER_SIZE = 26
+)
+
+var (
+	ErrInvalidSpanContext = errors.New("trace: invalid span context")
+
+	spanContextStringEncoding = base64.RawURLEncoding
+)
+
+// SpanContextBytes encodes the span context and baggage of the span into a
+// compact binary form. It returns nil if the span has no valid span context.
+func (s *SeveritySpan) SpanContextBytes() []byte {
+	sc := s.span.SpanContext()
+	if !sc.IsValid() {
+		return nil
+	}
+
+	var (
+		traceID    = sc.TraceID()
+		spanID     = sc.SpanID()
+		traceState = sc.TraceState().String()
+		bag        = baggage.FromContext(s.Context()).String()
+	)
+
+	buf := make([]byte, 0, __SPAN_CONTEXT_ENCODING_HEADER_SIZE+len(traceState)+len(bag)+2*binary.MaxVarintLen16)
+	buf = append(buf, __SPAN_CONTEXT_ENCODING_VERSION)
+	buf = append(buf, traceID[:]...)
+	buf = append(buf, spanID[:]...)
+	buf = append(buf, byte(sc.TraceFlags()))
+	buf = binary.AppendUvarint(buf, uint64(len(traceState)))
+	buf = append(buf, traceState...)
+	buf = binary.AppendUvarint(buf, uint64(len(bag)))
+	buf = append(buf, bag...)
+	return buf
+}
+
+// SpanContextString is like SpanContextBytes, but returns the URL-safe
+// base64 form.
+func (s *SeveritySpan) SpanContextString() string {
+	buf := s.SpanContextBytes()
+	if buf == nil {
+		return ""
+	}
+	return spanContextStringEncoding.EncodeToString(buf)
+}
+
+// ParseSpanContextBytes decodes data produced by SeveritySpan.SpanContextBytes.
+// The returned span context is marked as remote.
+func ParseSpanContextBytes(data []byte) (trace.SpanContext, baggage.Baggage, error) {
+	if len(data) < __SPAN_CONTEXT_ENCODING_HEADER_SIZE {
+		return trace.SpanContext{}, baggage.Baggage{}, fmt.Errorf("%w: data too short", ErrInvalidSpanContext)
+	}
+	if data[0] != __SPAN_CONTEXT_ENCODING_VERSION {
+		return trace.SpanContext{}, baggage.Baggage{}, fmt.Errorf("%w: unsupported version %d", ErrInvalidSpanContext, data[0])
+	}
+
+	var (
+		traceID trace.TraceID
+		spanID  trace.SpanID
+	)
+	copy(traceID[:], data[1:17])
+	copy(spanID[:], data[17:25])
+	flags := trace.TraceFlags(data[25])
+
+	rest := data[__SPAN_CONTEXT_ENCODING_HEADER_SIZE:]
+	traceState, rest, ok := readUvarintString(rest)
+	if !ok {
+		return trace.SpanContext{}, baggage.Baggage{}, fmt.Errorf("%w: malformed tracestate", ErrInvalidSpanContext)
+	}
+	bag, rest, ok := readUvarintString(rest)
+	if !ok {
+		return trace.SpanContext{}, baggage.Baggage{}, fmt.Errorf("%w: malformed baggage", ErrInvalidSpanContext)
+	}
+	if len(rest) > 0 {
+		return trace.SpanContext{}, baggage.Baggage{}, fmt.Errorf("%w: trailing data", ErrInvalidSpanContext)
+	}
+
+	ts, err := trace.ParseTraceState(traceState)
+	if err != nil {
+		return trace.SpanContext{}, baggage.Baggage{}, fmt.Errorf("%w: %v", ErrInvalidSpanContext, err)
+	}
+	b, err := baggage.Parse(bag)
+	if err != nil {
+		return trace.SpanContext{}, baggage.Baggage{}, fmt.Errorf("%w: %v", ErrInvalidSpanContext, err)
+	}
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: flags,
+		TraceState: ts,
+		Remote:     true,
+	})
+	if !sc.IsValid() {
+		return trace.SpanContext{}, baggage.Baggage{}, fmt.Errorf("%w: zero trace id or span id", ErrInvalidSpanContext)
+	}
+	return sc, b, nil
+}
+
+// ParseSpanContextString decodes v produced by SeveritySpan.SpanContextString.
+func ParseSpanContextString(v string) (trace.SpanContext, baggage.Baggage, error) {
+	data, err := spanContextStringEncoding.DecodeString(v)
+	if err != nil {
+		return trace.SpanContext{}, baggage.Baggage{}, fmt.Errorf("%w: %v", ErrInvalidSpanContext, err)
+	}
+	return ParseSpanContextBytes(data)
+}
+
+// Restore starts a child span of the span context encoded in v by
+// SeveritySpan.SpanContextString.
+func (s *SeverityTracer) Restore(
+	ctx context.Context,
+	v string,
+	spanName string,
+	opts ...trace.SpanStartOption) (*SeveritySpan, error) {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	sc, bag, err := ParseSpanContextString(v)
+	if err != nil {
+		return nil, err
+	}
+	ctx = baggage.ContextWithBaggage(ctx, bag)
+	ctx = trace.ContextWithRemoteSpanContext(ctx, sc)
+	return s.Start(ctx, spanName, opts...), nil
+}
+
+// RestoreLink starts a new root span linked to the span context encoded in
+// v by SeveritySpan.SpanContextString.
+func (s *SeverityTracer) RestoreLink(
+	ctx context.Context,
+	v string,
+	spanName string,
+	opts ...trace.SpanStartOption) (*SeveritySpan, error) {
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	sc, bag, err := ParseSpanContextString(v)
+	if err != nil {
+		return nil, err
+	}
+	ctx = baggage.ContextWithBaggage(ctx, bag)
+	opts = append(opts, trace.WithLinks(Link{SpanContext: sc}))
+	return s.Open(ctx, spanName, opts...), nil
+}
+
+func readUvarintString(data []byte) (string, []byte, bool) {
+	size, n := binary.Uvarint(data)
+	if n <= 0 || size > uint64(len(data)-n) {
+		return "", nil, false
+	}
+	data = data[n:]
+	return string(data[:size]), data[size:], true
+}
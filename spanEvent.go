@@ -1,6 +1,8 @@
 package trace
 
 import (
+	"slices"
+	"sync"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
@@ -51,6 +53,7 @@ func (NopEvent) Vars(v any) {
 }
 
 type SeverityEvent struct {
+	mu   sync.Mutex
 	span trace.Span
 
 	timestamp time.Time
@@ -63,35 +66,27 @@ type SeverityEvent struct {
 
 // IsRecording implements SpanEvent
 func (s *SeverityEvent) IsRecording() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 	return !s.flushed
 }
 
 // Flush implements SpanEvent
 func (s *SeverityEvent) Flush() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if !s.flushed {
 		s.flushed = true
-
-		s.tags = append(s.tags,
-			__ATTR_EVENT_MESSAGE.String(s.message),
-			__ATTR_EVENT_SEVERITY.String(s.severity.Name()),
-		)
-
-		if s.err != nil {
-			err := s.err
-			s.span.RecordError(err, trace.WithAttributes(
-				s.tags...,
-			), trace.WithTimestamp(s.timestamp))
-		} else {
-			s.span.AddEvent(string(__ATTR_EVENT), trace.WithAttributes(
-				s.tags...,
-			), trace.WithTimestamp(s.timestamp))
-		}
-
+		s.writeTo(s.span)
 	}
 }
 
 // Error implements SpanEvent
 func (s *SeverityEvent) Error(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if !s.flushed {
 		s.err = err
 	}
@@ -99,6 +94,9 @@ func (s *SeverityEvent) Error(err error) {
 
 // Tags implements SpanEvent
 func (s *SeverityEvent) Tags(tags ...KeyValue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if !s.flushed {
 		s.tags = append(s.tags, tags...)
 	}
@@ -106,8 +104,36 @@ func (s *SeverityEvent) Tags(tags ...KeyValue) {
 
 // Vars implements SpanEvent
 func (s *SeverityEvent) Vars(v any) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
 	if !s.flushed {
 		tags := expandObject(string(__ATTR_VARS), v)
 		s.tags = append(s.tags, tags...)
 	}
 }
+
+// copyTo writes the event to span without flushing it.
+func (s *SeverityEvent) copyTo(span trace.Span) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.writeTo(span)
+}
+
+func (s *SeverityEvent) writeTo(span trace.Span) {
+	tags := append(slices.Clip(s.tags),
+		__ATTR_EVENT_MESSAGE.String(s.message),
+		__ATTR_EVENT_SEVERITY.String(s.severity.Name()),
+	)
+
+	if s.err != nil {
+		span.RecordError(s.err, trace.WithAttributes(
+			tags...,
+		), trace.WithTimestamp(s.timestamp))
+	} else {
+		span.AddEvent(string(__ATTR_EVENT), trace.WithAttributes(
+			tags...,
+		), trace.WithTimestamp(s.timestamp))
+	}
+}
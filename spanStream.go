@@ -0,0 +1,133 @@
+package trace
+
+import (
+	"slices"
+	"time"
+	"weak"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Flush writes the pending events to the span now instead of on End. Events
+// flushed cannot be tagged anymore.
+func (s *SeveritySpan) Flush() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.flushLocked()
+}
+
+// Stream makes the span write its events before End, which suits spans
+// living for a long time such as sessions or stream consumers. With a zero
+// interval the pending events are written whenever a new event is created,
+// otherwise they are written every interval, once they are pending for at
+// least one interval, until End.
+func (s *SeveritySpan) Stream(interval time.Duration) {
+	if !s.span.IsRecording() {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stopStreamLocked()
+	s.streaming = true
+	if interval > 0 {
+		stop := make(chan struct{})
+		s.streamStop = stop
+		go streamEvery(weak.Make(s), interval, stop)
+	}
+}
+
+// Checkpoint emits a child span named name holding a copy of the events
+// created since the previous checkpoint, so they are visible before the span
+// ends. The events are still written to the span itself.
+func (s *SeveritySpan) Checkpoint(name string) {
+	if !s.span.IsRecording() {
+		return
+	}
+
+	s.mu.Lock()
+	events := slices.Clone(s.events[s.checkpoint:])
+	s.checkpoint = len(s.events)
+	s.mu.Unlock()
+
+	if len(events) == 0 {
+		return
+	}
+
+	opts := []trace.SpanStartOption{
+		trace.WithAttributes(__ATTR_CHECKPOINT.Bool(true)),
+	}
+	if e, ok := events[0].(*SeverityEvent); ok {
+		opts = append(opts, trace.WithTimestamp(e.timestamp))
+	}
+
+	span := s.childTracer().Start(s.Context(), name, opts...)
+	for _, v := range events {
+		if e, ok := v.(*SeverityEvent); ok {
+			e.copyTo(span.otelSpan())
+		}
+	}
+	span.End()
+}
+
+func (s *SeveritySpan) flushLocked() {
+	s.flushFirstLocked(len(s.events))
+}
+
+// flushBefore writes the pending events appended before mark, a value
+// returned by an earlier call, and returns the mark of the events appended so
+// far.
+func (s *SeveritySpan) flushBefore(mark int) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	first := s.appended - len(s.events)
+	s.flushFirstLocked(min(mark-first, len(s.events)))
+	return s.appended
+}
+
+func (s *SeveritySpan) flushFirstLocked(n int) {
+	if n <= 0 {
+		return
+	}
+
+	for _, e := range s.events[:n] {
+		e.Flush()
+	}
+	rest := copy(s.events, s.events[n:])
+	clear(s.events[rest:])
+	s.events = s.events[:rest]
+	s.checkpoint = max(s.checkpoint-n, 0)
+}
+
+func (s *SeveritySpan) stopStreamLocked() {
+	if s.streamStop != nil {
+		close(s.streamStop)
+		s.streamStop = nil
+	}
+	s.streaming = false
+}
+
+// streamEvery holds the span weakly, so a span collected without End stops
+// its stream and can be reported by the leak detector.
+func streamEvery(wp weak.Pointer[SeveritySpan], interval time.Duration, stop <-chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var mark int
+	for {
+		select {
+		case <-ticker.C:
+			s := wp.Value()
+			if s == nil {
+				return
+			}
+			// an event created since the previous tick may still be tagged
+			mark = s.flushBefore(mark)
+		case <-stop:
+			return
+		}
+	}
+}
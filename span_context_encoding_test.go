@@ -0,0 +1,125 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	oteltrace "go.opentelemetry.io/otel/trace"
+)
+
+func TestSeveritySpan_SpanContextString(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+		trace.WithSyncer(exporter),
+	))
+	tracer := tp.Tracer("test-tracer")
+
+	member, _ := baggage.NewMember("tenant", "acme")
+	bag, _ := baggage.New(member)
+	ts, _ := oteltrace.ParseTraceState("vendor=value")
+
+	ctx := baggage.ContextWithBaggage(context.Background(), bag)
+	ctx = oteltrace.ContextWithSpanContext(ctx, testSpanContext.WithTraceState(ts))
+	span := tracer.Start(ctx, "enqueue")
+	span.End()
+
+	v := span.SpanContextString()
+	if len(v) == 0 {
+		t.Fatal("Expected non-empty span context string")
+	}
+
+	sc, b, err := ParseSpanContextString(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sc.TraceID() != span.TraceID() || sc.SpanID() != span.SpanID() || sc.TraceFlags() != span.TraceFlags() {
+		t.Errorf("ParseSpanContextString(): unexpected span context %v", sc)
+	}
+	if sc.TraceState().Get("vendor") != "value" {
+		t.Errorf("tracestate vendor: expect %q, but got %q", "value", sc.TraceState().Get("vendor"))
+	}
+	if b.Member("tenant").Value() != "acme" {
+		t.Errorf("baggage tenant: expect %q, but got %q", "acme", b.Member("tenant").Value())
+	}
+
+	// restore as child
+	job, err := tracer.Restore(context.Background(), v, "job")
+	if err != nil {
+		t.Fatal(err)
+	}
+	job.End()
+	if job.TraceID() != span.TraceID() {
+		t.Error("Expected restored span to continue the trace")
+	}
+	if baggage.FromContext(job.Context()).Member("tenant").Value() != "acme" {
+		t.Error("Expected restored span to carry baggage")
+	}
+
+	// restore as link
+	linked, err := tracer.RestoreLink(context.Background(), v, "job")
+	if err != nil {
+		t.Fatal(err)
+	}
+	linked.End()
+	if linked.TraceID() == span.TraceID() {
+		t.Error("Expected linked span to start a new trace")
+	}
+
+	spans := exporter.GetSpans()
+	if spans[1].Parent.SpanID() != span.SpanID() {
+		t.Error("Expected restored span to be a child of the original span")
+	}
+	if len(spans[2].Links) != 1 || spans[2].Links[0].SpanContext.SpanID() != span.SpanID() {
+		t.Error("Expected linked span to link to the original span")
+	}
+}
+
+func TestSeveritySpan_SpanContextBytes_NoopSpan(t *testing.T) {
+	span := CreateSeveritySpan(context.Background())
+	if span.SpanContextBytes() != nil {
+		t.Error("Expected nil bytes for noop span")
+	}
+	if span.SpanContextString() != "" {
+		t.Error("Expected empty string for noop span")
+	}
+}
+
+func TestParseSpanContextString_Malformed(t *testing.T) {
+	span := CreateSeveritySpan(oteltrace.ContextWithSpanContext(context.Background(), testSpanContext))
+	valid := span.SpanContextBytes()
+
+	truncated := valid[:len(valid)-1]
+	trailing := append(append([]byte{}, valid...), 0)
+	badVersion := append([]byte{1}, valid[1:]...)
+	zeroIDs := make([]byte, len(valid))
+
+	testCases := []struct {
+		name string
+		v    string
+	}{
+		{"Empty", ""},
+		{"NotBase64", "!!!"},
+		{"Truncated", spanContextStringEncoding.EncodeToString(truncated)},
+		{"Trailing", spanContextStringEncoding.EncodeToString(trailing)},
+		{"BadVersion", spanContextStringEncoding.EncodeToString(badVersion)},
+		{"ZeroIDs", spanContextStringEncoding.EncodeToString(zeroIDs)},
+	}
+
+	tracer := CreateSeverityTracerProvider(trace.NewTracerProvider()).Tracer("test-tracer")
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := ParseSpanContextString(tc.v)
+			if !errors.Is(err, ErrInvalidSpanContext) {
+				t.Errorf("Expected ErrInvalidSpanContext, got %v", err)
+			}
+			span, err := tracer.Restore(context.Background(), tc.v, "job")
+			if err == nil || span != nil {
+				t.Error("Expected Restore to reject malformed input")
+			}
+		})
+	}
+}
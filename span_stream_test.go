@@ -0,0 +1,140 @@
+package trace
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSeveritySpan_Flush(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSpanProcessor(recorder)))
+
+	span := tp.Tracer("stream-test").Start(context.Background(), "session")
+	span.Info("connected")
+	span.Flush()
+
+	started := recorder.Started()[0]
+	if n := len(started.Events()); n != 1 {
+		t.Fatalf("Expected 1 event before End, got %d", n)
+	}
+
+	span.Info("disconnected")
+	span.End()
+
+	if n := len(recorder.Ended()[0].Events()); n != 2 {
+		t.Errorf("Expected 2 events after End, got %d", n)
+	}
+}
+
+func TestSeveritySpan_Stream(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSpanProcessor(recorder)))
+
+	span := tp.Tracer("stream-test").Start(context.Background(), "session")
+	defer span.End()
+	span.Stream(0)
+
+	span.Info("first").Tags(Key("seq").Int(1))
+	span.Info("second")
+
+	events := recorder.Started()[0].Events()
+	if len(events) != 1 {
+		t.Fatalf("Expected the first event to be written, got %d events", len(events))
+	}
+	if len(events[0].Attributes) != 3 {
+		t.Errorf("Expected the first event to keep its tags, got %v", events[0].Attributes)
+	}
+}
+
+func TestSeveritySpan_StreamInterval(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSpanProcessor(recorder)))
+
+	span := tp.Tracer("stream-test").Start(context.Background(), "session")
+	span.Stream(10 * time.Millisecond)
+	span.Info("message")
+
+	started := recorder.Started()[0]
+	deadline := time.Now().Add(2 * time.Second)
+	for len(started.Events()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	if n := len(started.Events()); n != 1 {
+		t.Errorf("Expected 1 event written by the timer, got %d", n)
+	}
+
+	span.End()
+	if n := len(recorder.Ended()[0].Events()); n != 1 {
+		t.Errorf("Expected the event to be written once, got %d", n)
+	}
+}
+
+func TestSeveritySpan_StreamInterval_KeepsNewestEvent(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSpanProcessor(recorder)))
+
+	span := tp.Tracer("stream-test").Start(context.Background(), "session")
+	defer span.End()
+	span.Stream(20 * time.Millisecond)
+
+	event := span.Info("message")
+	time.Sleep(5 * time.Millisecond)
+	event.Tags(Key("late").Bool(true))
+
+	started := recorder.Started()[0]
+	deadline := time.Now().Add(2 * time.Second)
+	for len(started.Events()) == 0 && time.Now().Before(deadline) {
+		time.Sleep(5 * time.Millisecond)
+	}
+	events := started.Events()
+	if len(events) != 1 {
+		t.Fatalf("Expected 1 event written by the timer, got %d", len(events))
+	}
+	var tagged bool
+	for _, attr := range events[0].Attributes {
+		if attr.Key == "late" {
+			tagged = true
+		}
+	}
+	if !tagged {
+		t.Errorf("Expected tags added after creation to be written, got %v", events[0].Attributes)
+	}
+}
+
+func TestSeveritySpan_Checkpoint(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSpanProcessor(recorder)))
+
+	span := tp.Tracer("stream-test").Start(context.Background(), "session")
+	span.Info("one")
+	span.Info("two")
+	span.Checkpoint("session.checkpoint")
+	span.Info("three")
+	span.Checkpoint("session.checkpoint")
+	span.Checkpoint("session.checkpoint")
+	span.End()
+
+	ended := recorder.Ended()
+	if len(ended) != 3 {
+		t.Fatalf("Expected 2 checkpoints and the span, got %d spans", len(ended))
+	}
+	for i, expected := range []int{2, 1} {
+		checkpoint := ended[i]
+		if checkpoint.Parent().SpanID() != span.SpanID() {
+			t.Errorf("checkpoint #%d: expect parent %s, got %s", i, span.SpanID(), checkpoint.Parent().SpanID())
+		}
+		if n := len(checkpoint.Events()); n != expected {
+			t.Errorf("checkpoint #%d: expect %d events, got %d", i, expected, n)
+		}
+	}
+	if n := len(ended[2].Events()); n != 3 {
+		t.Errorf("Expected all 3 events on the span, got %d", n)
+	}
+	if scope := ended[0].InstrumentationScope().Name; scope != "stream-test" {
+		t.Errorf("Expected checkpoint started by tracer %q, got %q", "stream-test", scope)
+	}
+}
@@ -0,0 +1,30 @@
+package trace
+
+import (
+	"context"
+)
+
+// SuppressInstrumentation returns a copy of ctx beneath which
+// SeverityTracer.Start returns a noop span and SeverityTracer.Inject injects
+// nothing, e.g. for calls made to the telemetry backend itself.
+func SuppressInstrumentation(ctx context.Context) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return context.WithValue(ctx, __CONTEXT_SUPPRESS_KEY, true)
+}
+
+// IsInstrumentationSuppressed reports whether ctx is beneath
+// SuppressInstrumentation.
+func IsInstrumentationSuppressed(ctx context.Context) bool {
+	suppressed, _ := ctx.Value(__CONTEXT_SUPPRESS_KEY).(bool)
+	return suppressed
+}
+
+func suppressedSpan(ctx context.Context) *SeveritySpan {
+	return &SeveritySpan{
+		span:     noopSpan,
+		ctx:      ctx,
+		disabled: true,
+	}
+}
@@ -0,0 +1,38 @@
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSuppressInstrumentation(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+	tracer := tp.Tracer("suppress-test")
+
+	parent := tracer.Start(context.Background(), "parent")
+	ctx := SuppressInstrumentation(ContextWithSpan(parent.Context(), parent))
+
+	span := tracer.Start(ctx, "export")
+	if !IsNoopSeveritySpan(span) {
+		t.Error("Expected a noop span beneath a suppressed context")
+	}
+	span.Info("ignored")
+	span.Start("nested").End()
+	span.End()
+
+	carrier := make(propagation.MapCarrier)
+	tracer.InjectWithPropagator(ctx, propagation.TraceContext{}, carrier)
+	if len(carrier) != 0 {
+		t.Errorf("Expected nothing injected, got %v", carrier)
+	}
+
+	parent.End()
+	if n := len(exporter.GetSpans()); n != 1 {
+		t.Errorf("Expected only the parent to be exported, got %d spans", n)
+	}
+}
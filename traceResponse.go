@@ -0,0 +1,144 @@
+package trace
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+const (
+	TraceResponseHeader = "traceresponse"
+
+	__TRACE_URL_TRACE_ID_PLACEHOLDER = "{trace_id}"
+	__TRACE_URL_SPAN_ID_PLACEHOLDER  = "{span_id}"
+)
+
+var (
+	_ TraceURLBuilder = TraceURLTemplate("")
+)
+
+// TraceURLBuilder builds the URL of a trace in a tracing UI.
+type TraceURLBuilder interface {
+	BuildTraceURL(traceID TraceID, spanID SpanID) string
+}
+
+// TraceURLTemplate is a TraceURLBuilder replacing the {trace_id} and
+// {span_id} placeholders, e.g. "http://localhost:16686/trace/{trace_id}".
+type TraceURLTemplate string
+
+// JaegerTraceURL returns the TraceURLTemplate of the Jaeger UI at baseURL.
+func JaegerTraceURL(baseURL string) TraceURLTemplate {
+	return TraceURLTemplate(strings.TrimSuffix(baseURL, "/") + "/trace/" + __TRACE_URL_TRACE_ID_PLACEHOLDER)
+}
+
+// BuildTraceURL implements TraceURLBuilder
+func (t TraceURLTemplate) BuildTraceURL(traceID TraceID, spanID SpanID) string {
+	url := strings.ReplaceAll(string(t), __TRACE_URL_TRACE_ID_PLACEHOLDER, traceID.String())
+	return strings.ReplaceAll(url, __TRACE_URL_SPAN_ID_PLACEHOLDER, spanID.String())
+}
+
+// TraceURL renders the URL of the span's trace with the global
+// TraceURLBuilder. It returns an empty string if no TraceURLBuilder is set
+// or the span has no trace ID.
+func (s *SeveritySpan) TraceURL() string {
+	builder := GetTraceURLBuilder()
+	if builder == nil || !s.HasTraceID() {
+		return ""
+	}
+	return builder.BuildTraceURL(s.TraceID(), s.SpanID())
+}
+
+// WriteTraceResponse sets the trace of span on the response header. When
+// header is empty the W3C traceresponse header is written, otherwise the
+// header carries the trace ID only.
+func WriteTraceResponse(w http.ResponseWriter, span *SeveritySpan, header string) {
+	if span == nil || !span.HasTraceID() {
+		return
+	}
+
+	if len(header) == 0 {
+		var sb strings.Builder
+		sb.Grow(55)
+		sb.WriteString("00-")
+		sb.WriteString(span.TraceID().String())
+		sb.WriteByte('-')
+		sb.WriteString(span.SpanID().String())
+		sb.WriteByte('-')
+		sb.WriteString(span.TraceFlags().String())
+		w.Header().Set(TraceResponseHeader, sb.String())
+		return
+	}
+	w.Header().Set(header, span.TraceID().String())
+}
+
+// TraceResponseHandler wraps h to write the trace on every response right
+// before its header is sent, see WriteTraceResponse. The trace is the one of
+// the first span h starts from the request context with a SeverityTracer, or
+// else of the span in the request context. Middleware starting the server
+// span with another tracer, e.g. otelhttp, must wrap the returned handler.
+func TraceResponseHandler(h http.Handler, header string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tw := &traceResponseWriter{
+			ResponseWriter: w,
+			ctx:            r.Context(),
+			header:         header,
+		}
+		h.ServeHTTP(tw, r.WithContext(context.WithValue(r.Context(), __CONTEXT_TRACE_RESPONSE_KEY, tw)))
+		// the header is sent after h returns if h wrote nothing
+		tw.writeTraceResponse()
+	})
+}
+
+type traceResponseWriter struct {
+	http.ResponseWriter
+
+	ctx     context.Context
+	header  string
+	span    atomic.Pointer[SeveritySpan]
+	written bool
+}
+
+func (w *traceResponseWriter) WriteHeader(statusCode int) {
+	w.writeTraceResponse()
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+
+func (w *traceResponseWriter) Write(b []byte) (int, error) {
+	w.writeTraceResponse()
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush implements http.Flusher
+func (w *traceResponseWriter) Flush() {
+	w.writeTraceResponse()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the wrapped ResponseWriter, see http.ResponseController.
+func (w *traceResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func (w *traceResponseWriter) writeTraceResponse() {
+	if w.written {
+		return
+	}
+	w.written = true
+
+	span := w.span.Load()
+	if span == nil {
+		span = SpanFromContext(w.ctx)
+	}
+	WriteTraceResponse(w.ResponseWriter, span, w.header)
+}
+
+// trackTraceResponse hands the first span started beneath a request served
+// by TraceResponseHandler to its ResponseWriter.
+func trackTraceResponse(ctx context.Context, span *SeveritySpan) {
+	if w, ok := ctx.Value(__CONTEXT_TRACE_RESPONSE_KEY).(*traceResponseWriter); ok {
+		w.span.CompareAndSwap(nil, span)
+	}
+}
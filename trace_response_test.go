@@ -0,0 +1,127 @@
+package trace
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestTraceResponseHandler(t *testing.T) {
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider())
+	span := tp.Tracer("test-tracer").Open(context.Background(), "request")
+	defer span.End()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	testCases := []struct {
+		name     string
+		header   string
+		key      string
+		expected string
+	}{
+		{"W3C", "", "traceresponse", "00-" + span.TraceID().String() + "-" + span.SpanID().String() + "-01"},
+		{"Custom", "X-Trace-Id", "X-Trace-Id", span.TraceID().String()},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req = req.WithContext(ContextWithSpan(req.Context(), span))
+			rec := httptest.NewRecorder()
+
+			TraceResponseHandler(handler, tc.header).ServeHTTP(rec, req)
+
+			if v := rec.Header().Get(tc.key); v != tc.expected {
+				t.Errorf("%s: expect %q, but got %q", tc.key, tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestTraceResponseHandler_InnerSpan(t *testing.T) {
+	tracer := CreateSeverityTracerProvider(trace.NewTracerProvider()).Tracer("test-tracer")
+
+	var span *SeveritySpan
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		span = tracer.Extract(r.Context(), propagation.HeaderCarrier(r.Header), "request")
+		defer span.End()
+
+		child := tracer.Start(span.Context(), "query")
+		child.End()
+
+		w.Write([]byte("ok"))
+	})
+
+	rec := httptest.NewRecorder()
+	TraceResponseHandler(handler, "").ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	expected := "00-" + span.TraceID().String() + "-" + span.SpanID().String() + "-01"
+	if v := rec.Header().Get(TraceResponseHeader); v != expected {
+		t.Errorf("%s: expect %q, but got %q", TraceResponseHeader, expected, v)
+	}
+}
+
+func TestTraceResponseHandler_InnerSpan_NoWrite(t *testing.T) {
+	tracer := CreateSeverityTracerProvider(trace.NewTracerProvider()).Tracer("test-tracer")
+
+	var span *SeveritySpan
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		span = tracer.Start(r.Context(), "request")
+		span.End()
+	})
+
+	rec := httptest.NewRecorder()
+	TraceResponseHandler(handler, "X-Trace-Id").ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if v := rec.Header().Get("X-Trace-Id"); v != span.TraceID().String() {
+		t.Errorf("X-Trace-Id: expect %q, but got %q", span.TraceID().String(), v)
+	}
+}
+
+func TestTraceResponseHandler_NoSpan(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+
+	rec := httptest.NewRecorder()
+	TraceResponseHandler(handler, "").ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	if v := rec.Header().Get(TraceResponseHeader); v != "" {
+		t.Errorf("Expected no traceresponse header, got %q", v)
+	}
+}
+
+func TestSeveritySpan_TraceURL(t *testing.T) {
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider())
+	span := tp.Tracer("test-tracer").Open(context.Background(), "request")
+	defer span.End()
+
+	originalBuilder := GetTraceURLBuilder()
+	defer SetTraceURLBuilder(originalBuilder)
+
+	SetTraceURLBuilder(nil)
+	if url := span.TraceURL(); url != "" {
+		t.Errorf("Expected empty trace URL without builder, got %q", url)
+	}
+
+	SetTraceURLBuilder(JaegerTraceURL("http://localhost:16686/"))
+	expected := "http://localhost:16686/trace/" + span.TraceID().String()
+	if url := span.TraceURL(); url != expected {
+		t.Errorf("TraceURL(): expect %q, but got %q", expected, url)
+	}
+
+	SetTraceURLBuilder(TraceURLTemplate("https://ui/{trace_id}?span={span_id}"))
+	expected = "https://ui/" + span.TraceID().String() + "?span=" + span.SpanID().String()
+	if url := span.TraceURL(); url != expected {
+		t.Errorf("TraceURL(): expect %q, but got %q", expected, url)
+	}
+
+	noop := CreateSeveritySpan(context.Background())
+	if url := noop.TraceURL(); url != "" {
+		t.Errorf("Expected empty trace URL for noop span, got %q", url)
+	}
+}
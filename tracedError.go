@@ -0,0 +1,83 @@
+package trace
+
+import (
+	"errors"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	_ SeverityError = new(TracedError)
+)
+
+// TracedError is an error carrying the span where it originated, see
+// SeveritySpan.WrapError.
+type TracedError struct {
+	err      error
+	sc       trace.SpanContext
+	severity Severity
+}
+
+// Error implements error
+func (e *TracedError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *TracedError) Unwrap() error {
+	return e.err
+}
+
+// TraceID returns the trace ID of the span where the error originated.
+func (e *TracedError) TraceID() TraceID {
+	return e.sc.TraceID()
+}
+
+// SpanID returns the span ID of the span where the error originated.
+func (e *TracedError) SpanID() SpanID {
+	return e.sc.SpanID()
+}
+
+// SpanContext returns the span context of the span where the error
+// originated.
+func (e *TracedError) SpanContext() trace.SpanContext {
+	return e.sc
+}
+
+// Severity implements SeverityError
+func (e *TracedError) Severity() Severity {
+	return e.severity
+}
+
+// WrapError wraps err into a *TracedError carrying the trace ID and span ID
+// of s, so logs and responses far from s can be correlated with it. When an
+// outer span records the error with Err, it links to s. An error already
+// traced is returned as is, and nil for nil.
+func (s *SeveritySpan) WrapError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var traced *TracedError
+	if errors.As(err, &traced) {
+		return err
+	}
+	return &TracedError{
+		err:      err,
+		sc:       s.span.SpanContext(),
+		severity: errorSeverity(err),
+	}
+}
+
+// linkTracedError links s to the span where err originated, if it is
+// another span.
+func (s *SeveritySpan) linkTracedError(err error) {
+	var traced *TracedError
+	if !errors.As(err, &traced) {
+		return
+	}
+	if !traced.sc.IsValid() || traced.sc.SpanID() == s.SpanID() {
+		return
+	}
+	s.span.AddLink(Link{SpanContext: traced.sc})
+}
@@ -0,0 +1,68 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var errNotFound = errors.New("not found")
+
+func TestSeveritySpan_WrapError(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := CreateSeverityTracerProvider(trace.NewTracerProvider(trace.WithSyncer(exporter)))
+	tracer := tp.Tracer("traced-error-test")
+
+	outer := tracer.Start(context.Background(), "handler")
+	inner := tracer.Open(context.Background(), "repository")
+
+	err := inner.WrapError(errNotFound)
+	inner.Err(err)
+	inner.End()
+
+	// bubbles up through another layer
+	err = fmt.Errorf("get user: %w", err)
+	if !errors.Is(err, errNotFound) {
+		t.Error("Expected traced error to match the wrapped error")
+	}
+	var traced *TracedError
+	if !errors.As(err, &traced) {
+		t.Fatal("Expected error to be a *TracedError")
+	}
+	if traced.TraceID() != inner.TraceID() || traced.SpanID() != inner.SpanID() {
+		t.Errorf("Expected error to carry %s/%s, got %s/%s",
+			inner.TraceID(), inner.SpanID(), traced.TraceID(), traced.SpanID())
+	}
+	if traced.Severity() != ERR {
+		t.Errorf("Severity(): expect %q, got %q", ERR.Name(), traced.Severity().Name())
+	}
+	if inner.WrapError(err) != err {
+		t.Error("Expected an already traced error to be returned as is")
+	}
+
+	outer.Err(err)
+	outer.End()
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+	if n := len(spans[0].Links); n != 0 {
+		t.Errorf("Expected no link on the originating span, got %d", n)
+	}
+	links := spans[1].Links
+	if len(links) != 1 || links[0].SpanContext.SpanID() != inner.SpanID() {
+		t.Errorf("Expected outer span to link to %s, got %v", inner.SpanID(), links)
+	}
+}
+
+func TestSeveritySpan_WrapError_Nil(t *testing.T) {
+	span := CreateSeveritySpan(context.Background())
+	if span.WrapError(nil) != nil {
+		t.Error("Expected nil for nil error")
+	}
+}
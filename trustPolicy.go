@@ -0,0 +1,80 @@
+package trace
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TrustContinue continues the remote trace.
+	TrustContinue TrustDecision = iota
+	// TrustLink starts a new root span linked to the remote span context and
+	// drops the remote baggage.
+	TrustLink
+	// TrustReject starts a new root span and drops the remote span context
+	// and baggage.
+	TrustReject
+)
+
+var (
+	trustDecisionNames = []string{
+		TrustContinue: "continue",
+		TrustLink:     "link",
+		TrustReject:   "reject",
+	}
+)
+
+type (
+	// TrustDecision tells how inbound trace context is handled on extraction.
+	TrustDecision int8
+
+	// TrustPolicy decides whether the trace context in carrier can be trusted,
+	// e.g. by the source IP stored in ctx or by a header signature.
+	TrustPolicy func(ctx context.Context, carrier propagation.TextMapCarrier) TrustDecision
+)
+
+func (d TrustDecision) Name() string {
+	if d < 0 || int(d) >= len(trustDecisionNames) {
+		return trustDecisionNames[TrustReject]
+	}
+	return trustDecisionNames[d]
+}
+
+// WithTrustPolicy returns a copy of the tracer whose Extract and
+// ExtractWithPropagator consult policy before continuing a remote trace.
+// The decision is recorded as the trace.trust attribute on the new span.
+func (s *SeverityTracer) WithTrustPolicy(policy TrustPolicy) *SeverityTracer {
+	tr := *s
+	tr.trustPolicy = policy
+	return &tr
+}
+
+func (s *SeverityTracer) extractWithTrustPolicy(
+	ctx context.Context,
+	propagator propagation.TextMapPropagator,
+	carrier propagation.TextMapCarrier,
+	spanName string,
+	opts ...trace.SpanStartOption) *SeveritySpan {
+
+	var span *SeveritySpan
+
+	decision := s.trustPolicy(ctx, carrier)
+	switch decision {
+	case TrustContinue:
+		span = s.Start(propagator.Extract(ctx, carrier), spanName, opts...)
+	case TrustLink:
+		remote := trace.SpanContextFromContext(propagator.Extract(ctx, carrier))
+		if remote.IsValid() && !remote.Equal(trace.SpanContextFromContext(ctx)) {
+			opts = append(opts, trace.WithLinks(Link{SpanContext: remote}))
+		}
+		span = s.Open(ctx, spanName, opts...)
+	default:
+		decision = TrustReject
+		span = s.Open(ctx, spanName, opts...)
+	}
+
+	span.Tags(__ATTR_TRACE_TRUST.String(decision.Name()))
+	return span
+}
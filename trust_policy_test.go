@@ -0,0 +1,97 @@
+package trace
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/baggage"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSeverityTracer_WithTrustPolicy(t *testing.T) {
+	propagator := propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	)
+
+	testCases := []struct {
+		name          string
+		decision      TrustDecision
+		continueTrace bool
+		links         int
+		baggage       bool
+	}{
+		{"Continue", TrustContinue, true, 0, true},
+		{"Link", TrustLink, false, 1, false},
+		{"Reject", TrustReject, false, 0, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			exporter := tracetest.NewInMemoryExporter()
+			tp := CreateSeverityTracerProvider(trace.NewTracerProvider(
+				trace.WithSyncer(exporter),
+			))
+
+			carrier := propagation.MapCarrier{
+				"traceparent": "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+				"baggage":     "tenant=acme",
+			}
+
+			var policyCalled bool
+			tracer := tp.Tracer("test-tracer").WithTrustPolicy(
+				func(ctx context.Context, c propagation.TextMapCarrier) TrustDecision {
+					policyCalled = true
+					return tc.decision
+				})
+
+			span := tracer.ExtractWithPropagator(context.Background(), propagator, carrier, "edge")
+			span.End()
+
+			if !policyCalled {
+				t.Error("Expected trust policy to be called")
+			}
+			if (span.TraceID() == testTraceID) != tc.continueTrace {
+				t.Errorf("Expected continue trace %v, got trace ID %s", tc.continueTrace, span.TraceID())
+			}
+			hasBaggage := baggage.FromContext(span.Context()).Len() > 0
+			if hasBaggage != tc.baggage {
+				t.Errorf("Expected baggage %v, got %v", tc.baggage, hasBaggage)
+			}
+
+			spans := exporter.GetSpans()
+			if len(spans[0].Links) != tc.links {
+				t.Errorf("Expected %d links, got %d", tc.links, len(spans[0].Links))
+			}
+			if tc.links > 0 && spans[0].Links[0].SpanContext.TraceID() != testTraceID {
+				t.Error("Expected link to reference the remote span context")
+			}
+
+			var decision string
+			for _, attr := range spans[0].Attributes {
+				if attr.Key == __ATTR_TRACE_TRUST {
+					decision = attr.Value.AsString()
+				}
+			}
+			if decision != tc.decision.Name() {
+				t.Errorf("Expected %s attribute %q, got %q", __ATTR_TRACE_TRUST, tc.decision.Name(), decision)
+			}
+		})
+	}
+}
+
+func TestSeverityTracer_WithTrustPolicy_Copy(t *testing.T) {
+	tracer := CreateSeverityTracerProvider(trace.NewTracerProvider()).Tracer("test-tracer")
+	untrusted := tracer.WithTrustPolicy(func(context.Context, propagation.TextMapCarrier) TrustDecision {
+		return TrustReject
+	})
+
+	if tracer.trustPolicy != nil {
+		t.Error("Expected WithTrustPolicy not to modify the original tracer")
+	}
+	if untrusted.trustPolicy == nil {
+		t.Error("Expected WithTrustPolicy to set the policy on the copy")
+	}
+}